@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"io"
+	"time"
 
 	cid "github.com/ipfs/go-cid"
 	"github.com/textileio/filecoin/deals"
@@ -18,19 +19,34 @@ type Deals struct {
 	client pb.APIClient
 }
 
-// WatchEvent is used to send data or error values for Watch
+// WatchEvent is used to send data or error values for Watch. Summary is set
+// on the last event when watching until all proposals are done.
 type WatchEvent struct {
-	Deal deals.DealInfo
-	Err  error
+	Deal    deals.DealInfo
+	Summary *deals.WatchSummary
+	Err     error
+}
+
+// AskChangeEvent is used to send data or error values for WatchAsks
+type AskChangeEvent struct {
+	Change ask.AskChange
+	Err    error
 }
 
 // AvailableAsks executes a query to retrieve active Asks
 func (d *Deals) AvailableAsks(ctx context.Context, query ask.Query) ([]ask.StorageAsk, error) {
 	q := &pb.Query{
-		MaxPrice:  query.MaxPrice,
-		PieceSize: query.PieceSize,
-		Limit:     int32(query.Limit),
-		Offset:    int32(query.Offset),
+		MaxPrice:       query.MaxPrice,
+		PieceSize:      query.PieceSize,
+		ExcludedMiners: query.ExcludedMiners,
+		IncludedMiners: query.IncludedMiners,
+		NotExpired:     query.NotExpired,
+		MinPower:       query.MinPower,
+		Countries:      query.Countries,
+		OnlyVerified:   query.OnlyVerified,
+		SortBy:         pb.SortOrder(query.SortBy),
+		Limit:          int32(query.Limit),
+		Offset:         int32(query.Offset),
 	}
 	reply, err := d.client.AvailableAsks(ctx, &pb.AvailableAsksRequest{Query: q})
 	if err != nil {
@@ -38,90 +54,320 @@ func (d *Deals) AvailableAsks(ctx context.Context, query ask.Query) ([]ask.Stora
 	}
 	asks := make([]ask.StorageAsk, len(reply.GetAsks()))
 	for i, a := range reply.GetAsks() {
-		asks[i] = ask.StorageAsk{
-			Price:        a.GetPrice(),
-			MinPieceSize: a.GetMinPieceSize(),
-			Miner:        a.GetMiner(),
-			Timestamp:    a.GetTimestamp(),
-			Expiry:       a.GetExpiry(),
-		}
+		asks[i] = fromPbStorageAsk(a)
 	}
 	return asks, nil
 }
 
+// WatchAsks returns a channel that receives the ask changes of miners, or of
+// all miners if none is specified
+func (d *Deals) WatchAsks(ctx context.Context, miners ...string) (<-chan AskChangeEvent, error) {
+	stream, err := d.client.WatchAsks(ctx, &pb.WatchAsksRequest{Miners: miners})
+	if err != nil {
+		return nil, err
+	}
+	channel := make(chan AskChangeEvent)
+	go func() {
+		defer close(channel)
+		for {
+			c, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				stat := status.Convert(err)
+				if stat == nil || (stat.Code() != codes.Canceled) {
+					channel <- AskChangeEvent{Err: err}
+				}
+				break
+			}
+			channel <- AskChangeEvent{Change: ask.AskChange{
+				Type:     ask.ChangeType(c.GetType()),
+				Ask:      fromPbStorageAsk(c.GetAsk()),
+				OldPrice: c.GetOldPrice(),
+			}}
+		}
+	}()
+	return channel, nil
+}
+
+// AskHistory returns the prices of miner asks observed between from and to. If
+// miner is empty, prices of all miners are returned. Zero times don't bound
+// the range.
+func (d *Deals) AskHistory(ctx context.Context, miner string, from, to time.Time) (ask.PriceHistory, error) {
+	req := &pb.AskHistoryRequest{Miner: miner}
+	if !from.IsZero() {
+		req.From = from.UnixNano()
+	}
+	if !to.IsZero() {
+		req.To = to.UnixNano()
+	}
+	reply, err := d.client.AskHistory(ctx, req)
+	if err != nil {
+		return ask.PriceHistory{}, err
+	}
+	history := ask.PriceHistory{
+		Points:       make([]ask.PricePoint, len(reply.GetPoints())),
+		DailyMedians: make([]ask.DailyPrice, len(reply.GetDailyMedians())),
+		Volatility:   reply.GetVolatility(),
+	}
+	for i, pp := range reply.GetPoints() {
+		history.Points[i] = ask.PricePoint{
+			Miner: pp.GetMiner(),
+			Price: pp.GetPrice(),
+			Time:  time.Unix(0, pp.GetTime()),
+		}
+	}
+	for i, dp := range reply.GetDailyMedians() {
+		history.DailyMedians[i] = ask.DailyPrice{
+			Day:    time.Unix(0, dp.GetDay()).UTC(),
+			Median: dp.GetMedian(),
+		}
+	}
+	return history, nil
+}
+
 // Store creates a proposal deal for data using wallet addr to all miners indicated
 // by dealConfigs for duration epochs
-func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []deals.DealConfig, duration uint64) ([]cid.Cid, []deals.DealConfig, error) {
+func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []deals.DealConfig, duration uint64, opts ...deals.StoreOption) ([]cid.Cid, []deals.FailedDeal, error) {
 	stream, err := d.client.Store(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	reqDealConfigs := make([]*pb.DealConfig, len(dealConfigs))
-	for i, dealConfig := range dealConfigs {
-		reqDealConfigs[i] = &pb.DealConfig{
-			Miner:      dealConfig.Miner,
-			EpochPrice: dealConfig.EpochPrice.Uint64(),
-		}
-	}
+	reqDealConfigs := toPbDealConfigs(dealConfigs)
 	storeParams := &pb.StoreParams{
-		Address:     addr,
-		DealConfigs: reqDealConfigs,
-		Duration:    duration,
+		Address:       addr,
+		DealConfigs:   reqDealConfigs,
+		Duration:      duration,
+		RetryPolicy:   toPbRetryPolicy(opts),
+		RenewalPolicy: toPbRenewalPolicy(opts),
+		ChunkSize:     storeConfig(opts).ChunkSize,
+		Encryption:    toPbEncryption(opts),
 	}
 	innerReq := &pb.StoreRequest_StoreParams{StoreParams: storeParams}
 
 	if err = stream.Send(&pb.StoreRequest{Payload: innerReq}); err != nil {
 		return nil, nil, err
 	}
+	err = sendChunks(data, func(chunk []byte) error {
+		return stream.Send(&pb.StoreRequest{Payload: &pb.StoreRequest_Chunk{Chunk: chunk}})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	reply, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromStoreReply(reply, opts)
+}
 
-	buffer := make([]byte, 1024*32) // 32KB
-	for {
-		bytesRead, err := data.Read(buffer)
-		if err != nil && err != io.EOF {
-			return nil, nil, err
-		}
-		sendErr := stream.Send(&pb.StoreRequest{Payload: &pb.StoreRequest_Chunk{Chunk: buffer[:bytesRead]}})
-		if sendErr != nil {
-			return nil, nil, sendErr
-		}
-		if err == io.EOF {
-			break
-		}
+// StoreAuto creates a proposal deal for data using wallet addr to the best
+// miners satisfying config, automatically selected by the server, for duration
+// epochs
+func (d *Deals) StoreAuto(ctx context.Context, addr string, data io.Reader, config deals.AutoConfig, duration uint64, opts ...deals.StoreOption) ([]cid.Cid, []deals.FailedDeal, error) {
+	stream, err := d.client.StoreAuto(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeAutoParams := &pb.StoreAutoParams{
+		Address:           addr,
+		ReplicationFactor: int32(config.ReplicationFactor),
+		MaxPrice:          config.MaxPrice,
+		PieceSize:         config.PieceSize,
+		ExcludedCountries: config.ExcludedCountries,
+		MinReputation:     int32(config.MinReputation),
+		Duration:          duration,
+		RetryPolicy:       toPbRetryPolicy(opts),
+		RenewalPolicy:     toPbRenewalPolicy(opts),
+		ChunkSize:         storeConfig(opts).ChunkSize,
+		Encryption:        toPbEncryption(opts),
+	}
+	innerReq := &pb.StoreAutoRequest_StoreAutoParams{StoreAutoParams: storeAutoParams}
+
+	if err = stream.Send(&pb.StoreAutoRequest{Payload: innerReq}); err != nil {
+		return nil, nil, err
+	}
+	err = sendChunks(data, func(chunk []byte) error {
+		return stream.Send(&pb.StoreAutoRequest{Payload: &pb.StoreAutoRequest_Chunk{Chunk: chunk}})
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 	reply, err := stream.CloseAndRecv()
 	if err != nil {
 		return nil, nil, err
 	}
+	return fromStoreReply(reply, opts)
+}
 
-	cids := make([]cid.Cid, len(reply.GetCids()))
-	for i, replyCid := range reply.GetCids() {
-		id, err := cid.Decode(replyCid)
+// StoreProfile creates a proposal deal for data using wallet addr to the best
+// miners satisfying the storage profile with the indicated name
+func (d *Deals) StoreProfile(ctx context.Context, addr string, data io.Reader, profile string, opts ...deals.StoreOption) ([]cid.Cid, []deals.FailedDeal, error) {
+	stream, err := d.client.StoreProfile(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeProfileParams := &pb.StoreProfileParams{
+		Address:     addr,
+		Profile:     profile,
+		RetryPolicy: toPbRetryPolicy(opts),
+		ChunkSize:   storeConfig(opts).ChunkSize,
+		Encryption:  toPbEncryption(opts),
+	}
+	innerReq := &pb.StoreProfileRequest_StoreProfileParams{StoreProfileParams: storeProfileParams}
+
+	if err = stream.Send(&pb.StoreProfileRequest{Payload: innerReq}); err != nil {
+		return nil, nil, err
+	}
+	err = sendChunks(data, func(chunk []byte) error {
+		return stream.Send(&pb.StoreProfileRequest{Payload: &pb.StoreProfileRequest_Chunk{Chunk: chunk}})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	reply, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromStoreReply(reply, opts)
+}
+
+// CreateProfile creates a new named storage profile
+func (d *Deals) CreateProfile(ctx context.Context, profile deals.Profile) error {
+	_, err := d.client.CreateProfile(ctx, &pb.CreateProfileRequest{Profile: toPbProfile(profile)})
+	return err
+}
+
+// UpdateProfile overwrites an existing storage profile
+func (d *Deals) UpdateProfile(ctx context.Context, profile deals.Profile) error {
+	_, err := d.client.UpdateProfile(ctx, &pb.UpdateProfileRequest{Profile: toPbProfile(profile)})
+	return err
+}
+
+// GetProfile returns the storage profile with the indicated name
+func (d *Deals) GetProfile(ctx context.Context, name string) (deals.Profile, error) {
+	reply, err := d.client.GetProfile(ctx, &pb.GetProfileRequest{Name: name})
+	if err != nil {
+		return deals.Profile{}, err
+	}
+	return fromPbProfile(reply.GetProfile()), nil
+}
+
+// ListProfiles returns all the storage profiles
+func (d *Deals) ListProfiles(ctx context.Context) ([]deals.Profile, error) {
+	reply, err := d.client.ListProfiles(ctx, &pb.ListProfilesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]deals.Profile, len(reply.GetProfiles()))
+	for i, p := range reply.GetProfiles() {
+		profiles[i] = fromPbProfile(p)
+	}
+	return profiles, nil
+}
+
+// DeleteProfile removes the storage profile with the indicated name
+func (d *Deals) DeleteProfile(ctx context.Context, name string) error {
+	_, err := d.client.DeleteProfile(ctx, &pb.DeleteProfileRequest{Name: name})
+	return err
+}
+
+// RegisterWebhook registers url to receive signed POSTs with state changes of
+// proposals, and of deals created with wallet addr if it isn't empty
+func (d *Deals) RegisterWebhook(ctx context.Context, url string, proposals []cid.Cid, addr string) (deals.Webhook, error) {
+	proposalStrings := make([]string, len(proposals))
+	for i, proposal := range proposals {
+		proposalStrings[i] = proposal.String()
+	}
+	req := &pb.RegisterWebhookRequest{Url: url, Proposals: proposalStrings, Address: addr}
+	reply, err := d.client.RegisterWebhook(ctx, req)
+	if err != nil {
+		return deals.Webhook{}, err
+	}
+	return fromPbWebhook(reply.GetWebhook())
+}
+
+// ListWebhooks returns all registered webhooks, without their secrets
+func (d *Deals) ListWebhooks(ctx context.Context) ([]deals.Webhook, error) {
+	reply, err := d.client.ListWebhooks(ctx, &pb.ListWebhooksRequest{})
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]deals.Webhook, len(reply.GetWebhooks()))
+	for i, wh := range reply.GetWebhooks() {
+		webhook, err := fromPbWebhook(wh)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
-		cids[i] = id
+		webhooks[i] = webhook
 	}
+	return webhooks, nil
+}
+
+// UnregisterWebhook removes the webhook with the indicated id
+func (d *Deals) UnregisterWebhook(ctx context.Context, id string) error {
+	_, err := d.client.UnregisterWebhook(ctx, &pb.UnregisterWebhookRequest{Id: id})
+	return err
+}
 
-	failedDeals := make([]deals.DealConfig, len(reply.GetFailedDeals()))
-	for i, dealConfig := range reply.GetFailedDeals() {
-		failedDeals[i] = deals.DealConfig{
-			Miner:      dealConfig.GetMiner(),
-			EpochPrice: types.NewInt(dealConfig.GetEpochPrice()),
+// ListDeliveries returns the delivery log of the webhook with the indicated id
+func (d *Deals) ListDeliveries(ctx context.Context, id string) ([]deals.Delivery, error) {
+	reply, err := d.client.ListDeliveries(ctx, &pb.ListDeliveriesRequest{WebhookID: id})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]deals.Delivery, len(reply.GetDeliveries()))
+	for i, del := range reply.GetDeliveries() {
+		info, err := fromPbDealInfo(del.GetInfo())
+		if err != nil {
+			return nil, err
+		}
+		var lastAttempt time.Time
+		if del.GetLastAttempt() != 0 {
+			lastAttempt = time.Unix(0, del.GetLastAttempt())
+		}
+		deliveries[i] = deals.Delivery{
+			ID:          del.GetId(),
+			WebhookID:   del.GetWebhookID(),
+			Info:        info,
+			Attempts:    int(del.GetAttempts()),
+			LastAttempt: lastAttempt,
+			Delivered:   del.GetDelivered(),
+			StatusCode:  int(del.GetStatusCode()),
+			Error:       del.GetError(),
 		}
 	}
-
-	return cids, failedDeals, nil
+	return deliveries, nil
 }
 
-// Watch returnas a channel with state changes of indicated proposals
-func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEvent, error) {
+// Watch returns a channel with state changes of indicated proposals. Every
+// state change that happened after since is replayed first, so a zero since
+// replays the full history. Options filter the state changes, and can close
+// the channel after a summary once every proposal is done.
+func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid, since time.Time, opts ...deals.WatchOption) (<-chan WatchEvent, error) {
+	var config deals.WatchConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
 	channel := make(chan WatchEvent)
 	proposalStrings := make([]string, len(proposals))
 	for i, proposal := range proposals {
 		proposalStrings[i] = proposal.String()
 	}
-	stream, err := d.client.Watch(ctx, &pb.WatchRequest{Proposals: proposalStrings})
+	req := &pb.WatchRequest{
+		Proposals: proposalStrings,
+		Miners:    config.Miners,
+		States:    config.States,
+		UntilDone: config.UntilDone,
+	}
+	if !since.IsZero() {
+		req.Since = since.UnixNano()
+	}
+	stream, err := d.client.Watch(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +375,9 @@ func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEve
 		defer close(channel)
 		for {
 			event, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
 			if err != nil {
 				stat := status.Convert(err)
 				if stat == nil || (stat.Code() != codes.Canceled) {
@@ -136,23 +385,521 @@ func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEve
 				}
 				break
 			}
-			proposalCid, err := cid.Decode(event.GetDealInfo().GetProposalCid())
+			if event.GetSummary() != nil {
+				summary := &deals.WatchSummary{Deals: make([]deals.DealInfo, len(event.GetSummary().GetDeals()))}
+				for i, di := range event.GetSummary().GetDeals() {
+					if summary.Deals[i], err = fromPbDealInfo(di); err != nil {
+						break
+					}
+				}
+				if err != nil {
+					channel <- WatchEvent{Err: err}
+					break
+				}
+				channel <- WatchEvent{Summary: summary}
+				continue
+			}
+			deal, err := fromPbDealInfo(event.GetDealInfo())
 			if err != nil {
 				channel <- WatchEvent{Err: err}
 				break
 			}
-			deal := deals.DealInfo{
-				ProposalCid:   proposalCid,
-				StateID:       event.GetDealInfo().GetStateID(),
-				StateName:     event.GetDealInfo().GetStateName(),
-				Miner:         event.GetDealInfo().GetMiner(),
-				PieceRef:      event.GetDealInfo().GetPieceRef(),
-				Size:          event.GetDealInfo().GetSize(),
-				PricePerEpoch: types.NewInt(event.GetDealInfo().GetPricePerEpoch()),
-				Duration:      event.GetDealInfo().GetDuration(),
-			}
 			channel <- WatchEvent{Deal: deal}
 		}
 	}()
 	return channel, nil
 }
+
+// Estimate returns the cost in attoFIL of storing size bytes for duration epochs
+// with the miners indicated by dealConfigs, without proposing any deal
+func (d *Deals) Estimate(ctx context.Context, size uint64, duration uint64, dealConfigs []deals.DealConfig) (deals.Estimation, error) {
+	reqDealConfigs := toPbDealConfigs(dealConfigs)
+	return d.estimate(ctx, &pb.EstimateRequest{Size: size, Duration: duration, DealConfigs: reqDealConfigs})
+}
+
+// EstimateAuto returns the cost in attoFIL of storing size bytes for duration
+// epochs with the miners that StoreAuto would select for config
+func (d *Deals) EstimateAuto(ctx context.Context, size uint64, duration uint64, config deals.AutoConfig) (deals.Estimation, error) {
+	req := &pb.EstimateRequest{
+		Size:              size,
+		Duration:          duration,
+		ReplicationFactor: int32(config.ReplicationFactor),
+		MaxPrice:          config.MaxPrice,
+	}
+	return d.estimate(ctx, req)
+}
+
+func (d *Deals) estimate(ctx context.Context, req *pb.EstimateRequest) (deals.Estimation, error) {
+	reply, err := d.client.Estimate(ctx, req)
+	if err != nil {
+		return deals.Estimation{}, err
+	}
+	total, err := types.BigFromString(reply.GetTotal())
+	if err != nil {
+		return deals.Estimation{}, err
+	}
+	e := deals.Estimation{
+		Total: total,
+		Deals: make([]deals.DealEstimation, len(reply.GetDeals())),
+	}
+	for i, de := range reply.GetDeals() {
+		cost, err := types.BigFromString(de.GetCost())
+		if err != nil {
+			return deals.Estimation{}, err
+		}
+		epochPrice, err := decodeOptionalBigInt(de.GetEpochPrice())
+		if err != nil {
+			return deals.Estimation{}, err
+		}
+		e.Deals[i] = deals.DealEstimation{
+			Miner:      de.GetMiner(),
+			EpochPrice: epochPrice,
+			Cost:       cost,
+			Warnings:   de.GetWarnings(),
+		}
+	}
+	return e, nil
+}
+
+// Retrieve fetches the data identified by dataCid from a miner storing it,
+// paying with wallet addr. Encrypted data is decrypted if a key is provided
+func (d *Deals) Retrieve(ctx context.Context, addr string, dataCid cid.Cid, opts ...deals.RetrieveOption) (io.Reader, error) {
+	var config deals.RetrieveConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	req := &pb.RetrieveRequest{Address: addr, Cid: dataCid.String(), Key: config.Key}
+	stream, err := d.client.Retrieve(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return receiveRetrieved(stream.Recv), nil
+}
+
+// receiveRetrieved returns a Reader of the chunks received with recv
+func receiveRetrieved(recv func() (*pb.RetrieveReply, error)) io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		for {
+			reply, err := recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				return
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+			if _, err := writer.Write(reply.GetChunk()); err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return reader
+}
+
+// StoreBatch packs the files of br in a single archive, and creates a proposal
+// deal for it as Store does. The returned Batch locates every file, so they
+// can be retrieved individually with RetrieveFile.
+func (d *Deals) StoreBatch(ctx context.Context, addr string, br deals.BatchReader, dealConfigs []deals.DealConfig, duration uint64, opts ...deals.StoreOption) (deals.Batch, []cid.Cid, []deals.FailedDeal, error) {
+	stream, err := d.client.StoreBatch(ctx)
+	if err != nil {
+		return deals.Batch{}, nil, nil, err
+	}
+
+	reqDealConfigs := toPbDealConfigs(dealConfigs)
+	storeParams := &pb.StoreParams{
+		Address:       addr,
+		DealConfigs:   reqDealConfigs,
+		Duration:      duration,
+		RetryPolicy:   toPbRetryPolicy(opts),
+		RenewalPolicy: toPbRenewalPolicy(opts),
+		ChunkSize:     storeConfig(opts).ChunkSize,
+		Encryption:    toPbEncryption(opts),
+	}
+	innerReq := &pb.StoreBatchRequest_StoreParams{StoreParams: storeParams}
+	if err = stream.Send(&pb.StoreBatchRequest{Payload: innerReq}); err != nil {
+		return deals.Batch{}, nil, nil, err
+	}
+	for {
+		bf, err := br.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return deals.Batch{}, nil, nil, err
+		}
+		header := &pb.StoreBatchRequest_File{File: &pb.BatchFileHeader{Name: bf.Name}}
+		if err := stream.Send(&pb.StoreBatchRequest{Payload: header}); err != nil {
+			return deals.Batch{}, nil, nil, err
+		}
+		err = sendChunks(bf.Data, func(chunk []byte) error {
+			return stream.Send(&pb.StoreBatchRequest{Payload: &pb.StoreBatchRequest_Chunk{Chunk: chunk}})
+		})
+		if err != nil {
+			return deals.Batch{}, nil, nil, err
+		}
+	}
+	reply, err := stream.CloseAndRecv()
+	if err != nil {
+		return deals.Batch{}, nil, nil, err
+	}
+	b, err := fromPbBatch(reply.GetBatch())
+	if err != nil {
+		return deals.Batch{}, nil, nil, err
+	}
+	proposals, failed, err := fromStoreReply(reply.GetStoreReply(), opts)
+	if err != nil {
+		return deals.Batch{}, nil, nil, err
+	}
+	return b, proposals, failed, nil
+}
+
+// GetBatch returns the Batch of files packed by StoreBatch
+func (d *Deals) GetBatch(ctx context.Context, batchCid cid.Cid) (deals.Batch, error) {
+	reply, err := d.client.GetBatch(ctx, &pb.GetBatchRequest{Cid: batchCid.String()})
+	if err != nil {
+		return deals.Batch{}, err
+	}
+	return fromPbBatch(reply.GetBatch())
+}
+
+// RetrieveFile returns the file with the indicated name of a Batch stored with
+// StoreBatch, paying with wallet addr
+func (d *Deals) RetrieveFile(ctx context.Context, addr string, batchCid cid.Cid, name string, opts ...deals.RetrieveOption) (io.Reader, error) {
+	var config deals.RetrieveConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	req := &pb.RetrieveFileRequest{Address: addr, BatchCid: batchCid.String(), Name: name, Key: config.Key}
+	stream, err := d.client.RetrieveFile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return receiveRetrieved(stream.Recv), nil
+}
+
+// ListDeals returns all the deals created by Store
+func (d *Deals) ListDeals(ctx context.Context) ([]deals.DealRecord, error) {
+	reply, err := d.client.ListDeals(ctx, &pb.ListDealsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]deals.DealRecord, len(reply.GetRecords()))
+	for i, r := range reply.GetRecords() {
+		record, err := fromPbDealRecord(r)
+		if err != nil {
+			return nil, err
+		}
+		records[i] = record
+	}
+	return records, nil
+}
+
+// GetDeal returns the deal created by Store with the indicated proposal cid
+func (d *Deals) GetDeal(ctx context.Context, proposal cid.Cid) (deals.DealRecord, error) {
+	reply, err := d.client.GetDeal(ctx, &pb.GetDealRequest{ProposalCid: proposal.String()})
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	return fromPbDealRecord(reply.GetRecord())
+}
+
+func fromPbDealInfo(di *pb.DealInfo) (deals.DealInfo, error) {
+	proposalCid, err := cid.Decode(di.GetProposalCid())
+	if err != nil {
+		return deals.DealInfo{}, err
+	}
+	renewedBy, err := decodeOptionalCid(di.GetRenewedBy())
+	if err != nil {
+		return deals.DealInfo{}, err
+	}
+	pricePerEpoch, err := decodeOptionalBigInt(di.GetPricePerEpoch())
+	if err != nil {
+		return deals.DealInfo{}, err
+	}
+	var updatedAt time.Time
+	if di.GetUpdatedAt() != 0 {
+		updatedAt = time.Unix(0, di.GetUpdatedAt())
+	}
+	return deals.DealInfo{
+		ProposalCid:   proposalCid,
+		StateID:       di.GetStateID(),
+		StateName:     di.GetStateName(),
+		Miner:         di.GetMiner(),
+		PieceRef:      di.GetPieceRef(),
+		Size:          di.GetSize(),
+		PricePerEpoch: pricePerEpoch,
+		Duration:      di.GetDuration(),
+		RenewedBy:     renewedBy,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
+
+func fromPbDealRecord(dr *pb.DealRecord) (deals.DealRecord, error) {
+	proposalCid, err := cid.Decode(dr.GetProposalCid())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	dataCid, err := cid.Decode(dr.GetDataCid())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	info, err := fromPbDealInfo(dr.GetInfo())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	renewalOf, err := decodeOptionalCid(dr.GetRenewalOf())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	renewedBy, err := decodeOptionalCid(dr.GetRenewedBy())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	manifestCid, err := decodeOptionalCid(dr.GetManifestCid())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	batchCid, err := decodeOptionalCid(dr.GetBatchCid())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	epochPrice, err := decodeOptionalBigInt(dr.GetEpochPrice())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	return deals.DealRecord{
+		ProposalCid:     proposalCid,
+		DataCid:         dataCid,
+		ManifestCid:     manifestCid,
+		BatchCid:        batchCid,
+		Addr:            dr.GetAddress(),
+		Miner:           dr.GetMiner(),
+		EpochPrice:      epochPrice,
+		Duration:        dr.GetDuration(),
+		CreatedAt:       time.Unix(0, dr.GetCreatedAt()),
+		ActivationEpoch: dr.GetActivationEpoch(),
+		RenewalOf:       renewalOf,
+		RenewedBy:       renewedBy,
+		Encryption:      fromPbEncryptionParams(dr.GetEncryption()),
+		Info:            info,
+	}, nil
+}
+
+func fromPbBatch(b *pb.Batch) (deals.Batch, error) {
+	batchCid, err := cid.Decode(b.GetCid())
+	if err != nil {
+		return deals.Batch{}, err
+	}
+	dataCid, err := cid.Decode(b.GetDataCid())
+	if err != nil {
+		return deals.Batch{}, err
+	}
+	files := make([]deals.BatchEntry, len(b.GetFiles()))
+	for i, f := range b.GetFiles() {
+		files[i] = deals.BatchEntry{Name: f.GetName(), Offset: f.GetOffset(), Size: f.GetSize()}
+	}
+	return deals.Batch{
+		Cid:       batchCid,
+		DataCid:   dataCid,
+		Size:      b.GetSize(),
+		Files:     files,
+		CreatedAt: time.Unix(0, b.GetCreatedAt()),
+	}, nil
+}
+
+func fromPbEncryptionParams(params *pb.EncryptionParams) *deals.EncryptionParams {
+	if params == nil {
+		return nil
+	}
+	return &deals.EncryptionParams{
+		Algorithm:  params.GetAlgorithm(),
+		IV:         params.GetIv(),
+		WrappedKey: params.GetWrappedKey(),
+	}
+}
+
+func toPbProfile(p deals.Profile) *pb.Profile {
+	profile := &pb.Profile{
+		Name:              p.Name,
+		ReplicationFactor: int32(p.ReplicationFactor),
+		MaxPrice:          p.MaxPrice,
+		Duration:          p.Duration,
+		ExcludedCountries: p.ExcludedCountries,
+		MinReputation:     int32(p.MinReputation),
+	}
+	if p.RenewalPolicy != nil {
+		profile.RenewalPolicy = &pb.RenewalPolicy{
+			Threshold: p.RenewalPolicy.Threshold,
+			MaxPrice:  p.RenewalPolicy.MaxPrice,
+		}
+	}
+	return profile
+}
+
+func fromPbProfile(p *pb.Profile) deals.Profile {
+	profile := deals.Profile{
+		Name:              p.GetName(),
+		ReplicationFactor: int(p.GetReplicationFactor()),
+		MaxPrice:          p.GetMaxPrice(),
+		Duration:          p.GetDuration(),
+		ExcludedCountries: p.GetExcludedCountries(),
+		MinReputation:     int(p.GetMinReputation()),
+	}
+	if rp := p.GetRenewalPolicy(); rp != nil {
+		profile.RenewalPolicy = &deals.RenewalPolicy{
+			Threshold: rp.GetThreshold(),
+			MaxPrice:  rp.GetMaxPrice(),
+		}
+	}
+	return profile
+}
+
+func fromPbWebhook(wh *pb.Webhook) (deals.Webhook, error) {
+	proposals := make([]cid.Cid, len(wh.GetProposals()))
+	for i, proposal := range wh.GetProposals() {
+		id, err := cid.Decode(proposal)
+		if err != nil {
+			return deals.Webhook{}, err
+		}
+		proposals[i] = id
+	}
+	return deals.Webhook{
+		ID:        wh.GetId(),
+		URL:       wh.GetUrl(),
+		Proposals: proposals,
+		Addr:      wh.GetAddress(),
+		Secret:    wh.GetSecret(),
+		CreatedAt: time.Unix(0, wh.GetCreatedAt()),
+	}, nil
+}
+
+// decodeOptionalCid decodes s, returning cid.Undef if it's empty
+func decodeOptionalCid(s string) (cid.Cid, error) {
+	if s == "" {
+		return cid.Undef, nil
+	}
+	return cid.Decode(s)
+}
+
+// fromPbStorageAsk converts a storage ask from its proto representation
+func fromPbStorageAsk(a *pb.StorageAsk) ask.StorageAsk {
+	return ask.StorageAsk{
+		Price:        a.GetPrice(),
+		MinPieceSize: a.GetMinPieceSize(),
+		Miner:        a.GetMiner(),
+		Timestamp:    a.GetTimestamp(),
+		Expiry:       a.GetExpiry(),
+		Verified:     a.GetVerified(),
+	}
+}
+
+// decodeOptionalBigInt parses the decimal string of a BigInt, returning a nil
+// BigInt if s is empty
+func decodeOptionalBigInt(s string) (types.BigInt, error) {
+	if s == "" {
+		return types.EmptyInt, nil
+	}
+	return types.BigFromString(s)
+}
+
+func toPbDealConfigs(dealConfigs []deals.DealConfig) []*pb.DealConfig {
+	reqDealConfigs := make([]*pb.DealConfig, len(dealConfigs))
+	for i, dealConfig := range dealConfigs {
+		reqDealConfigs[i] = &pb.DealConfig{
+			Miner:      dealConfig.Miner,
+			EpochPrice: dealConfig.EpochPrice.String(),
+		}
+	}
+	return reqDealConfigs
+}
+
+func sendChunks(data io.Reader, send func([]byte) error) error {
+	buffer := make([]byte, 1024*32) // 32KB
+	for {
+		bytesRead, err := data.Read(buffer)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if sendErr := send(buffer[:bytesRead]); sendErr != nil {
+			return sendErr
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func storeConfig(opts []deals.StoreOption) deals.StoreConfig {
+	var config deals.StoreConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+func toPbRetryPolicy(opts []deals.StoreOption) *pb.RetryPolicy {
+	rp := storeConfig(opts).RetryPolicy
+	if rp == nil {
+		return nil
+	}
+	return &pb.RetryPolicy{
+		MaxAttempts:       int32(rp.MaxAttempts),
+		Backoff:           int64(rp.Backoff / time.Second),
+		PriceBump:         rp.PriceBump,
+		ReplicationFactor: int32(rp.ReplicationFactor),
+	}
+}
+
+func toPbRenewalPolicy(opts []deals.StoreOption) *pb.RenewalPolicy {
+	rp := storeConfig(opts).RenewalPolicy
+	if rp == nil {
+		return nil
+	}
+	return &pb.RenewalPolicy{
+		Threshold: rp.Threshold,
+		MaxPrice:  rp.MaxPrice,
+	}
+}
+
+func toPbEncryption(opts []deals.StoreOption) *pb.Encryption {
+	ek := storeConfig(opts).Encryption
+	if ek == nil {
+		return nil
+	}
+	return &pb.Encryption{Enabled: true, PublicKey: ek.PublicKey}
+}
+
+func fromStoreReply(reply *pb.StoreReply, opts []deals.StoreOption) ([]cid.Cid, []deals.FailedDeal, error) {
+	if ek := storeConfig(opts).Encryption; ek != nil {
+		ek.Key = reply.GetEncryptionKey()
+		ek.WrappedKey = reply.GetWrappedKey()
+	}
+
+	cids := make([]cid.Cid, len(reply.GetCids()))
+	for i, replyCid := range reply.GetCids() {
+		id, err := cid.Decode(replyCid)
+		if err != nil {
+			return nil, nil, err
+		}
+		cids[i] = id
+	}
+
+	failedDeals := make([]deals.FailedDeal, len(reply.GetFailedDeals()))
+	for i, fd := range reply.GetFailedDeals() {
+		epochPrice, err := decodeOptionalBigInt(fd.GetDealConfig().GetEpochPrice())
+		if err != nil {
+			return nil, nil, err
+		}
+		failedDeals[i] = deals.FailedDeal{
+			DealConfig: deals.DealConfig{
+				Miner:      fd.GetDealConfig().GetMiner(),
+				EpochPrice: epochPrice,
+			},
+			Reason:  deals.FailureReason(fd.GetReason()),
+			Message: fd.GetMessage(),
+		}
+	}
+	return cids, failedDeals, nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/filecoin/deals"
@@ -21,6 +22,28 @@ func TestQueryAsk(t *testing.T) {
 	}
 }
 
+func TestWatchAsks(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	_, err := d.WatchAsks(ctx)
+	if err != nil {
+		t.Fatalf("failed to call WatchAsks: %v", err)
+	}
+}
+
+func TestAskHistory(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	_, err := d.AskHistory(ctx, "", time.Now().Add(-time.Hour), time.Time{})
+	if err != nil {
+		t.Fatalf("failed to call AskHistory: %v", err)
+	}
+}
+
 func TestStore(t *testing.T) {
 	skipIfShort(t)
 	d, done := setupDeals(t)
@@ -33,17 +56,131 @@ func TestStore(t *testing.T) {
 	}
 }
 
+func TestStoreAuto(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	r := strings.NewReader("store me")
+	config := deals.AutoConfig{ReplicationFactor: 1, MaxPrice: 5}
+	_, _, err := d.StoreAuto(ctx, "an address", r, config, 1024)
+	if err != nil {
+		t.Fatalf("failed to call StoreAuto: %v", err)
+	}
+}
+
+func TestStoreBatch(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	files := []deals.BatchFile{
+		{Name: "a.txt", Data: strings.NewReader("store me")},
+		{Name: "b.txt", Data: strings.NewReader("and me")},
+	}
+	_, _, _, err := d.StoreBatch(ctx, "an address", deals.NewBatchReader(files), make([]deals.DealConfig, 0), 1024)
+	if err != nil {
+		t.Fatalf("failed to call StoreBatch: %v", err)
+	}
+}
+
 func TestWatch(t *testing.T) {
 	skipIfShort(t)
 	d, done := setupDeals(t)
 	defer done()
 
-	_, err := d.Watch(ctx, make([]cid.Cid, 0))
+	_, err := d.Watch(ctx, make([]cid.Cid, 0), time.Time{})
 	if err != nil {
 		t.Fatalf("failed to call Watch: %v", err)
 	}
 }
 
+func TestEstimate(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	_, err := d.Estimate(ctx, 1024, 1000, make([]deals.DealConfig, 0))
+	if err != nil {
+		t.Fatalf("failed to call Estimate: %v", err)
+	}
+}
+
+func TestRetrieve(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	c, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	_, err = d.Retrieve(ctx, "an address", c)
+	if err != nil {
+		t.Fatalf("failed to call Retrieve: %v", err)
+	}
+}
+
+func TestListDeals(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	_, err := d.ListDeals(ctx)
+	if err != nil {
+		t.Fatalf("failed to call ListDeals: %v", err)
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	skipIfShort(t)
+	d, done := setupDeals(t)
+	defer done()
+
+	profile := deals.Profile{Name: "archive", ReplicationFactor: 2, MaxPrice: 5, Duration: 1024, ExcludedCountries: []string{"XX"}}
+	if err := d.CreateProfile(ctx, profile); err != nil {
+		t.Fatalf("failed to call CreateProfile: %v", err)
+	}
+	got, err := d.GetProfile(ctx, profile.Name)
+	if err != nil {
+		t.Fatalf("failed to call GetProfile: %v", err)
+	}
+	if got.Name != profile.Name || got.Duration != profile.Duration {
+		t.Fatalf("unexpected profile %v", got)
+	}
+	if _, err := d.ListProfiles(ctx); err != nil {
+		t.Fatalf("failed to call ListProfiles: %v", err)
+	}
+	if err := d.DeleteProfile(ctx, profile.Name); err != nil {
+		t.Fatalf("failed to call DeleteProfile: %v", err)
+	}
+}
+
+func TestFromPbCreatedAt(t *testing.T) {
+	t.Parallel()
+	c := "QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c"
+	createdAt := time.Unix(1590000000, 123456789)
+
+	dr, err := fromPbDealRecord(&pb.DealRecord{ProposalCid: c, DataCid: c, CreatedAt: createdAt.UnixNano(), Info: &pb.DealInfo{ProposalCid: c}})
+	if err != nil {
+		t.Fatalf("failed to decode deal record: %v", err)
+	}
+	b, err := fromPbBatch(&pb.Batch{Cid: c, DataCid: c, CreatedAt: createdAt.UnixNano()})
+	if err != nil {
+		t.Fatalf("failed to decode batch: %v", err)
+	}
+	wh, err := fromPbWebhook(&pb.Webhook{CreatedAt: createdAt.UnixNano()})
+	if err != nil {
+		t.Fatalf("failed to decode webhook: %v", err)
+	}
+	for _, got := range []time.Time{dr.CreatedAt, b.CreatedAt, wh.CreatedAt} {
+		if !got.Equal(createdAt) {
+			t.Fatalf("expected creation time %s, got %s", createdAt, got)
+		}
+	}
+	if !dr.Info.UpdatedAt.IsZero() {
+		t.Fatalf("expected zero update time, got %s", dr.Info.UpdatedAt)
+	}
+}
+
 func setupDeals(t *testing.T) (*Deals, func()) {
 	serverDone := setupServer(t)
 	conn, done := setupConnection(t)
@@ -2,10 +2,23 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/wallet"
 	pb "github.com/textileio/filecoin/wallet/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// LowBalanceEvent is used to send data or error values for LowBalanceAlerts
+type LowBalanceEvent struct {
+	Alert wallet.LowBalanceAlert
+	Err   error
+}
+
 // Wallet provides an API for managing filecoin wallets
 type Wallet struct {
 	client pb.APIClient
@@ -20,11 +33,194 @@ func (w *Wallet) NewWallet(ctx context.Context, typ string) (string, error) {
 	return resp.GetAddress(), nil
 }
 
-// WalletBalance gets a filecoin wallet's balance
-func (w *Wallet) WalletBalance(ctx context.Context, address string) (int64, error) {
+// WalletBalance gets a filecoin wallet's balance in attoFIL
+func (w *Wallet) WalletBalance(ctx context.Context, address string) (types.BigInt, error) {
 	resp, err := w.client.WalletBalance(ctx, &pb.WalletBalanceRequest{Address: address})
 	if err != nil {
-		return -1, err
+		return types.EmptyInt, err
+	}
+	return types.BigFromString(resp.GetBalance())
+}
+
+// WalletList returns the addresses of all the wallets
+func (w *Wallet) WalletList(ctx context.Context) ([]string, error) {
+	resp, err := w.client.WalletList(ctx, &pb.WalletListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAddresses(), nil
+}
+
+// WalletDefaultAddress returns the address of the default wallet
+func (w *Wallet) WalletDefaultAddress(ctx context.Context) (string, error) {
+	resp, err := w.client.WalletDefaultAddress(ctx, &pb.WalletDefaultAddressRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetAddress(), nil
+}
+
+// WalletSetDefault sets the wallet with address as the default one
+func (w *Wallet) WalletSetDefault(ctx context.Context, address string) error {
+	_, err := w.client.WalletSetDefault(ctx, &pb.WalletSetDefaultRequest{Address: address})
+	return err
+}
+
+// WalletExport returns the key of a wallet encrypted with passphrase
+func (w *Wallet) WalletExport(ctx context.Context, address string, passphrase string) ([]byte, error) {
+	resp, err := w.client.WalletExport(ctx, &pb.WalletExportRequest{Address: address, Passphrase: passphrase})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetKey(), nil
+}
+
+// WalletImport imports a key exported with WalletExport, returning the address
+// of the wallet
+func (w *Wallet) WalletImport(ctx context.Context, key []byte, passphrase string) (string, error) {
+	resp, err := w.client.WalletImport(ctx, &pb.WalletImportRequest{Key: key, Passphrase: passphrase})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetAddress(), nil
+}
+
+// Send transfers amount attoFIL from wallet from to address to, returning the
+// cid of the message
+func (w *Wallet) Send(ctx context.Context, from string, to string, amount types.BigInt, opts ...wallet.SendOption) (cid.Cid, error) {
+	var config wallet.SendConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	req := &pb.SendRequest{From: from, To: to, Amount: amount.String()}
+	if !config.GasPrice.Nil() {
+		req.GasPrice = config.GasPrice.String()
+	}
+	if !config.GasLimit.Nil() {
+		req.GasLimit = config.GasLimit.String()
+	}
+	resp, err := w.client.Send(ctx, req)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.Decode(resp.GetCid())
+}
+
+// MessageStatus returns the state of a message sent with Send
+func (w *Wallet) MessageStatus(ctx context.Context, msg cid.Cid) (wallet.MessageStatus, error) {
+	resp, err := w.client.MessageStatus(ctx, &pb.MessageStatusRequest{Cid: msg.String()})
+	if err != nil {
+		return wallet.MessageStatus{}, err
+	}
+	ms := wallet.MessageStatus{
+		Cid:      msg,
+		State:    wallet.MessageState(resp.GetState()),
+		ExitCode: uint8(resp.GetExitCode()),
+		Height:   resp.GetHeight(),
+	}
+	if resp.GetGasUsed() != "" {
+		if ms.GasUsed, err = types.BigFromString(resp.GetGasUsed()); err != nil {
+			return wallet.MessageStatus{}, err
+		}
+	}
+	return ms, nil
+}
+
+// WatchAddress tracks the balance of address, alerting when it falls under
+// threshold attoFIL
+func (w *Wallet) WatchAddress(ctx context.Context, address string, threshold types.BigInt) error {
+	_, err := w.client.WatchAddress(ctx, &pb.WatchAddressRequest{Address: address, Threshold: threshold.String()})
+	return err
+}
+
+// UnwatchAddress stops tracking the balance of address
+func (w *Wallet) UnwatchAddress(ctx context.Context, address string) error {
+	_, err := w.client.UnwatchAddress(ctx, &pb.UnwatchAddressRequest{Address: address})
+	return err
+}
+
+// ListWatched returns the addresses with tracked balances
+func (w *Wallet) ListWatched(ctx context.Context) ([]wallet.WatchedAddress, error) {
+	resp, err := w.client.ListWatched(ctx, &pb.ListWatchedRequest{})
+	if err != nil {
+		return nil, err
+	}
+	watched := make([]wallet.WatchedAddress, len(resp.GetAddresses()))
+	for i, wa := range resp.GetAddresses() {
+		watched[i].Addr = wa.GetAddress()
+		watched[i].Low = wa.GetLow()
+		if watched[i].Threshold, err = decodeOptionalBigInt(wa.GetThreshold()); err != nil {
+			return nil, err
+		}
+		if watched[i].Balance, err = decodeOptionalBigInt(wa.GetBalance()); err != nil {
+			return nil, err
+		}
+	}
+	return watched, nil
+}
+
+// BalanceHistory returns the balance changes of a watched address between from
+// and to. Zero values don't bound the range.
+func (w *Wallet) BalanceHistory(ctx context.Context, address string, from, to time.Time) ([]wallet.BalanceSample, error) {
+	req := &pb.BalanceHistoryRequest{Address: address}
+	if !from.IsZero() {
+		req.From = from.UnixNano()
+	}
+	if !to.IsZero() {
+		req.To = to.UnixNano()
+	}
+	resp, err := w.client.BalanceHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	samples := make([]wallet.BalanceSample, len(resp.GetSamples()))
+	for i, bs := range resp.GetSamples() {
+		samples[i].Height = bs.GetHeight()
+		samples[i].Time = time.Unix(0, bs.GetTime())
+		if samples[i].Balance, err = decodeOptionalBigInt(bs.GetBalance()); err != nil {
+			return nil, err
+		}
+	}
+	return samples, nil
+}
+
+// LowBalanceAlerts returns a channel that receives an event every time a
+// watched address falls under its threshold
+func (w *Wallet) LowBalanceAlerts(ctx context.Context) (<-chan LowBalanceEvent, error) {
+	stream, err := w.client.LowBalanceAlerts(ctx, &pb.LowBalanceAlertsRequest{})
+	if err != nil {
+		return nil, err
 	}
-	return resp.GetBalance(), nil
+	channel := make(chan LowBalanceEvent)
+	go func() {
+		defer close(channel)
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				stat := status.Convert(err)
+				if stat == nil || (stat.Code() != codes.Canceled) {
+					channel <- LowBalanceEvent{Err: err}
+				}
+				break
+			}
+			alert := wallet.LowBalanceAlert{
+				Addr:   event.GetAddress(),
+				Height: event.GetHeight(),
+				Time:   time.Unix(0, event.GetTime()),
+			}
+			if alert.Balance, err = decodeOptionalBigInt(event.GetBalance()); err != nil {
+				channel <- LowBalanceEvent{Err: err}
+				break
+			}
+			if alert.Threshold, err = decodeOptionalBigInt(event.GetThreshold()); err != nil {
+				channel <- LowBalanceEvent{Err: err}
+				break
+			}
+			channel <- LowBalanceEvent{Alert: alert}
+		}
+	}()
+	return channel, nil
 }
@@ -2,7 +2,9 @@ package client
 
 import (
 	"testing"
+	"time"
 
+	"github.com/textileio/filecoin/lotus/types"
 	pb "github.com/textileio/filecoin/wallet/pb"
 )
 
@@ -32,11 +34,52 @@ func TestWalletBalance(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to get wallet balance: %v", err)
 	}
-	if bal != 0 {
+	if !bal.IsZero() {
 		t.Fatalf("unexpected wallet balance: %v", bal)
 	}
 }
 
+func TestWalletList(t *testing.T) {
+	w, done := setupWallet(t)
+	defer done()
+
+	address, err := w.NewWallet(ctx, "bls")
+	checkErr(t, err)
+	addrs, err := w.WalletList(ctx)
+	checkErr(t, err)
+	found := false
+	for _, addr := range addrs {
+		if addr == address {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("new wallet %s not found in %v", address, addrs)
+	}
+
+	checkErr(t, w.WalletSetDefault(ctx, address))
+	def, err := w.WalletDefaultAddress(ctx)
+	checkErr(t, err)
+	if def != address {
+		t.Fatalf("expected default address %s, got %s", address, def)
+	}
+}
+
+func TestWalletExportImport(t *testing.T) {
+	w, done := setupWallet(t)
+	defer done()
+
+	address, err := w.NewWallet(ctx, "bls")
+	checkErr(t, err)
+	key, err := w.WalletExport(ctx, address, "passphrase")
+	checkErr(t, err)
+	imported, err := w.WalletImport(ctx, key, "passphrase")
+	checkErr(t, err)
+	if imported != address {
+		t.Fatalf("expected imported address %s, got %s", address, imported)
+	}
+}
+
 func setupWallet(t *testing.T) (*Wallet, func()) {
 	skipIfShort(t)
 	serverDone := setupServer(t)
@@ -46,3 +89,28 @@ func setupWallet(t *testing.T) (*Wallet, func()) {
 		serverDone()
 	}
 }
+
+func TestWatchAddress(t *testing.T) {
+	skipIfShort(t)
+	w, done := setupWallet(t)
+	defer done()
+
+	address, err := w.NewWallet(ctx, "bls")
+	checkErr(t, err)
+	if err := w.WatchAddress(ctx, address, types.NewInt(100)); err != nil {
+		t.Fatalf("failed to call WatchAddress: %v", err)
+	}
+	watched, err := w.ListWatched(ctx)
+	if err != nil {
+		t.Fatalf("failed to call ListWatched: %v", err)
+	}
+	if len(watched) != 1 || watched[0].Addr != address {
+		t.Fatalf("unexpected watched addresses %v", watched)
+	}
+	if _, err := w.BalanceHistory(ctx, address, time.Time{}, time.Time{}); err != nil {
+		t.Fatalf("failed to call BalanceHistory: %v", err)
+	}
+	if err := w.UnwatchAddress(ctx, address); err != nil {
+		t.Fatalf("failed to call UnwatchAddress: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ipfs/go-datastore"
 	badger "github.com/ipfs/go-ds-badger"
@@ -20,6 +21,7 @@ import (
 	"github.com/textileio/filecoin/index/slashing"
 	"github.com/textileio/filecoin/iplocation/ip2location"
 	"github.com/textileio/filecoin/lotus"
+	"github.com/textileio/filecoin/reputation"
 	txndstr "github.com/textileio/filecoin/txndstransform"
 	"github.com/textileio/filecoin/util"
 	"github.com/textileio/filecoin/wallet"
@@ -41,8 +43,10 @@ type Server struct {
 	dm   *deals.Module
 	ai   *ask.AskIndex
 	wm   *wallet.Module
+	ww   *wallet.Watcher
 	si   *slashing.SlashingIndex
 	mi   *miner.MinerIndex
+	rm   *reputation.ReputationModule
 	ip2l *ip2location.IP2Location
 
 	rpc           *grpc.Server
@@ -57,6 +61,9 @@ type Config struct {
 	LotusAuthToken  string
 	GrpcHostAddress ma.Multiaddr
 	RepoPath        string
+	ImportPath      string
+	MaxUploadSize   int64
+	ImportDiskQuota int64
 }
 
 // NewServer starts and returns a new server with the given configuration.
@@ -82,8 +89,6 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error when opening datastore on repo: %s", err)
 	}
-	dm := deals.New(txndstr.Wrap(ds, "dealmodule"), c)
-
 	ip2l := ip2location.New([]string{"./ip2location-ip4.bin"})
 	mi, err := miner.New(txndstr.Wrap(ds, "index/miner"), c, fchost, ip2l)
 	if err != nil {
@@ -95,14 +100,25 @@ func NewServer(conf Config) (*Server, error) {
 		return nil, fmt.Errorf("error when creating slashing index: %s", err)
 	}
 
-	ai, err := ask.New(txndstr.Wrap(ds, "index/ask"), c)
+	ai, err := ask.New(txndstr.Wrap(ds, "index/ask"), c, mi)
 	if err != nil {
 		return nil, fmt.Errorf("error when creating ask index: %s", err)
 	}
-	dealsService := deals.NewService(dm, ai)
-
+	rm := reputation.New(txndstr.Wrap(ds, "reputation"), mi, si, ai)
 	wm := wallet.New(c)
-	walletService := wallet.NewService(wm)
+	ww := wallet.NewWatcher(txndstr.Wrap(ds, "wallet/watcher"), c)
+	walletService := wallet.NewService(wm, ww)
+
+	dealsConf := deals.Config{
+		ImportPath:      conf.ImportPath,
+		MaxUploadSize:   conf.MaxUploadSize,
+		ImportDiskQuota: conf.ImportDiskQuota,
+	}
+	dm, err := deals.New(txndstr.Wrap(ds, "dealmodule"), c, ai, mi, si, rm, wm, dealsConf)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating deals module: %s", err)
+	}
+	dealsService := deals.NewService(dm, ai)
 
 	s := &Server{
 		// ToDo: Support secure connection
@@ -111,8 +127,10 @@ func NewServer(conf Config) (*Server, error) {
 		dm:            dm,
 		ai:            ai,
 		wm:            wm,
+		ww:            ww,
 		mi:            mi,
 		si:            si,
+		rm:            rm,
 		ip2l:          ip2l,
 		dealsService:  dealsService,
 		walletService: walletService,
@@ -136,8 +154,25 @@ func NewServer(conf Config) (*Server, error) {
 	go func() {
 		mux := http.NewServeMux()
 		mux.HandleFunc("/index/ask", func(w http.ResponseWriter, r *http.Request) {
-			index := ai.Get()
-			buf, err := json.MarshalIndent(index, "", "  ")
+			var res interface{} = ai.Get()
+			params := r.URL.Query()
+			if params.Get("miner") != "" || params.Get("from") != "" || params.Get("to") != "" {
+				from, err := parseTimeParam(params.Get("from"))
+				if err != nil {
+					http.Error(w, "Invalid from param", http.StatusBadRequest)
+					return
+				}
+				to, err := parseTimeParam(params.Get("to"))
+				if err != nil {
+					http.Error(w, "Invalid to param", http.StatusBadRequest)
+					return
+				}
+				if res, err = ai.History(params.Get("miner"), from, to); err != nil {
+					http.Error(w, "Error", http.StatusInternalServerError)
+					return
+				}
+			}
+			buf, err := json.MarshalIndent(res, "", "  ")
 			if err != nil {
 				http.Error(w, "Error", http.StatusInternalServerError)
 				return
@@ -172,10 +207,21 @@ func NewServer(conf Config) (*Server, error) {
 
 // Close shuts down the server
 func (s *Server) Close() {
-	s.rpc.GracefulStop()
+	if err := s.dm.Close(); err != nil {
+		log.Errorf("error when closing deals module: %s", err)
+	}
+	if err := s.ww.Close(); err != nil {
+		log.Errorf("error when closing wallet watcher: %s", err)
+	}
+	if err := s.rm.Close(); err != nil {
+		log.Errorf("error when closing reputation module: %s", err)
+	}
+	// The ask index ends WatchAsks streams, so it's closed before stopping
+	// the gRPC server
 	if err := s.ai.Close(); err != nil {
 		log.Errorf("error when closing ask index: %s", err)
 	}
+	s.rpc.GracefulStop()
 	if err := s.mi.Close(); err != nil {
 		log.Errorf("error when closing miner index: %s", err)
 	}
@@ -188,3 +234,12 @@ func (s *Server) Close() {
 	s.closeLotus()
 	s.ip2l.Close()
 }
+
+// parseTimeParam parses an RFC3339 query param, returning the zero time if
+// it's empty
+func parseTimeParam(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
@@ -0,0 +1,120 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/index/miner"
+	"github.com/textileio/filecoin/index/slashing"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// AutoConfig contains the requirements to automatically select miners in
+// StoreAuto
+type AutoConfig struct {
+	ReplicationFactor int
+	MaxPrice          uint64
+	PieceSize         uint64
+	ExcludedCountries []string
+	MinReputation     int
+}
+
+// StoreAuto creates a proposal deal for data using wallet addr to the best
+// ReplicationFactor miners that satisfy config, for duration epochs.
+func (m *Module) StoreAuto(ctx context.Context, addr string, data io.Reader, config AutoConfig, duration uint64, opts ...StoreOption) ([]cid.Cid, []FailedDeal, error) {
+	dealConfigs, err := m.selectDealConfigs(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m.Store(ctx, addr, data, dealConfigs, duration, opts...)
+}
+
+// selectDealConfigs returns DealConfigs for the best miners satisfying config
+func (m *Module) selectDealConfigs(config AutoConfig) ([]DealConfig, error) {
+	if config.ReplicationFactor <= 0 {
+		return nil, fmt.Errorf("replication factor should be greater than zero")
+	}
+	if m.askIndex == nil || m.minerIndex == nil || m.slashingIndex == nil {
+		return nil, fmt.Errorf("automatic miner selection isn't available")
+	}
+	asks, err := m.askIndex.Query(ask.Query{MaxPrice: config.MaxPrice, PieceSize: config.PieceSize})
+	if err != nil {
+		return nil, fmt.Errorf("error when querying asks: %s", err)
+	}
+	mi := m.minerIndex.Get()
+	asks, err = m.filterAsks(asks, mi, config)
+	if err != nil {
+		return nil, err
+	}
+	selected := selectMiners(asks, mi, m.slashingIndex.Get(), config.ReplicationFactor)
+	if len(selected) < config.ReplicationFactor {
+		return nil, fmt.Errorf("only %d miners satisfy the requirements, %d needed", len(selected), config.ReplicationFactor)
+	}
+	dealConfigs := make([]DealConfig, len(selected))
+	for i, sa := range selected {
+		dealConfigs[i] = DealConfig{
+			Miner:      sa.Miner,
+			EpochPrice: types.NewInt(sa.Price),
+		}
+	}
+	return dealConfigs, nil
+}
+
+// filterAsks removes asks of miners located in excluded countries or with a
+// reputation score lower than required by config
+func (m *Module) filterAsks(asks []ask.StorageAsk, mi miner.Index, config AutoConfig) ([]ask.StorageAsk, error) {
+	if config.MinReputation > 0 && m.reputation == nil {
+		return nil, fmt.Errorf("reputation filtering isn't available")
+	}
+	excluded := make(map[string]struct{}, len(config.ExcludedCountries))
+	for _, c := range config.ExcludedCountries {
+		excluded[strings.ToUpper(c)] = struct{}{}
+	}
+	filtered := make([]ask.StorageAsk, 0, len(asks))
+	for _, sa := range asks {
+		if _, ok := excluded[strings.ToUpper(mi.Meta.Info[sa.Miner].Location.Country)]; ok {
+			continue
+		}
+		if config.MinReputation > 0 {
+			if ms, ok := m.reputation.GetScore(sa.Miner); !ok || ms.Score < config.MinReputation {
+				continue
+			}
+		}
+		filtered = append(filtered, sa)
+	}
+	return filtered, nil
+}
+
+// selectMiners returns up to n asks from online miners. Miners with fewer
+// slashes are preferred, then cheaper asks, and then miners with more power.
+func selectMiners(asks []ask.StorageAsk, mi miner.Index, si slashing.Index, n int) []ask.StorageAsk {
+	candidates := make([]ask.StorageAsk, 0, len(asks))
+	for _, sa := range asks {
+		if meta, ok := mi.Meta.Info[sa.Miner]; !ok || !meta.Online {
+			continue
+		}
+		candidates = append(candidates, sa)
+	}
+	slashes := func(addr string) int {
+		return len(si.Miners[addr].Epochs)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si, sj := slashes(candidates[i].Miner), slashes(candidates[j].Miner)
+		if si != sj {
+			return si < sj
+		}
+		if candidates[i].Price != candidates[j].Price {
+			return candidates[i].Price < candidates[j].Price
+		}
+		return mi.Chain.Power[candidates[i].Miner].Power > mi.Chain.Power[candidates[j].Miner].Power
+	})
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
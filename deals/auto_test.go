@@ -0,0 +1,67 @@
+package deals
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/index/miner"
+	"github.com/textileio/filecoin/index/slashing"
+)
+
+func TestSelectMiners(t *testing.T) {
+	t.Parallel()
+	asks := []ask.StorageAsk{
+		{Miner: "t01", Price: 10},
+		{Miner: "t02", Price: 20},
+		{Miner: "t03", Price: 20},
+		{Miner: "t04", Price: 30},
+		{Miner: "t05", Price: 40},
+	}
+	mi := miner.Index{
+		Meta: miner.MetaIndex{
+			Info: map[string]miner.Meta{
+				"t01": {Online: true},
+				"t02": {Online: true},
+				"t03": {Online: true},
+				"t04": {Online: false},
+				"t05": {Online: true},
+			},
+		},
+		Chain: miner.ChainIndex{
+			Power: map[string]miner.Power{
+				"t02": {Power: 10},
+				"t03": {Power: 100},
+			},
+		},
+	}
+	si := slashing.Index{
+		Miners: map[string]slashing.Slashes{
+			"t01": {Epochs: []uint64{42}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		n      int
+		expect []string
+	}{
+		{name: "One", n: 1, expect: []string{"t03"}},
+		{name: "Three", n: 3, expect: []string{"t03", "t02", "t05"}},
+		{name: "All", n: 10, expect: []string{"t03", "t02", "t05", "t01"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			selected := selectMiners(asks, mi, si, tt.n)
+			got := make([]string, len(selected))
+			for i, sa := range selected {
+				got[i] = sa.Miner
+			}
+			if !reflect.DeepEqual(tt.expect, got) {
+				t.Fatalf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
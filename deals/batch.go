@@ -0,0 +1,251 @@
+package deals
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+)
+
+// Batch describes many files packed by StoreBatch in a single tar archive, so
+// they're stored once and can be retrieved individually
+type Batch struct {
+	Cid       cid.Cid
+	DataCid   cid.Cid
+	Size      uint64
+	Files     []BatchEntry
+	CreatedAt time.Time
+}
+
+// BatchEntry is the location of a file inside the archive of a Batch
+type BatchEntry struct {
+	Name   string
+	Offset uint64
+	Size   uint64
+}
+
+// BatchFile is a file to pack with StoreBatch
+type BatchFile struct {
+	Name string
+	Data io.Reader
+}
+
+// BatchReader provides the files packed by StoreBatch
+type BatchReader interface {
+	// Next returns the next file, or io.EOF when there are no more files.
+	// The data of a file is only read before the following call to Next.
+	Next() (BatchFile, error)
+}
+
+// NewBatchReader returns a BatchReader of files
+func NewBatchReader(files []BatchFile) BatchReader {
+	return &sliceBatchReader{files: files}
+}
+
+type sliceBatchReader struct {
+	files []BatchFile
+}
+
+func (r *sliceBatchReader) Next() (BatchFile, error) {
+	if len(r.files) == 0 {
+		return BatchFile{}, io.EOF
+	}
+	f := r.files[0]
+	r.files = r.files[1:]
+	return f, nil
+}
+
+// StoreBatch packs the files of br in a tar archive and stores it as Store
+// does. The returned Batch locates every file in the archive, and its cid is
+// used to retrieve them individually with RetrieveFile.
+func (m *Module) StoreBatch(ctx context.Context, addr string, br BatchReader, dealConfigs []DealConfig, duration uint64, opts ...StoreOption) (Batch, []cid.Cid, []FailedDeal, error) {
+	path, size, digest, entries, release, err := m.stageBatch(br)
+	if err != nil {
+		return Batch{}, nil, nil, err
+	}
+	defer release()
+	b, err := newBatch(size, digest, entries)
+	if err != nil {
+		return Batch{}, nil, nil, err
+	}
+	base := DealRecord{Addr: addr, Duration: duration, BatchCid: b.Cid}
+	dataCid, proposals, failed, err := m.storeStaged(ctx, base, path, size, dealConfigs, opts...)
+	if err != nil {
+		return Batch{}, nil, nil, err
+	}
+	b.DataCid = dataCid
+	if err := m.store.putBatch(b); err != nil {
+		return Batch{}, nil, nil, fmt.Errorf("error when saving batch: %s", err)
+	}
+	return b, proposals, failed, nil
+}
+
+// GetBatch returns the Batch of files packed by StoreBatch
+func (m *Module) GetBatch(c cid.Cid) (Batch, error) {
+	return m.store.getBatch(c)
+}
+
+// RetrieveFile fetches the archive of a Batch as Retrieve does, and returns
+// the file with the indicated name. The returned ReadCloser must be closed to
+// release the retrieved copy.
+func (m *Module) RetrieveFile(ctx context.Context, addr string, batchCid cid.Cid, name string, opts ...RetrieveOption) (io.ReadCloser, error) {
+	b, err := m.store.getBatch(batchCid)
+	if err != nil {
+		return nil, err
+	}
+	var entry *BatchEntry
+	for i := range b.Files {
+		if b.Files[i].Name == name {
+			entry = &b.Files[i]
+			break
+		}
+	}
+	if entry == nil {
+		return nil, ErrFileNotFound
+	}
+	f, err := m.retrieve(ctx, addr, b.DataCid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &sectionReadCloser{
+		Reader: io.NewSectionReader(f, int64(entry.Offset), int64(entry.Size)),
+		Closer: f,
+	}, nil
+}
+
+// stageBatch writes the files of br in a staged tar archive, returning its
+// path, size and sha256 digest, the location of every file, and a func that
+// removes it
+func (m *Module) stageBatch(br BatchReader) (string, uint64, []byte, []BatchEntry, func(), error) {
+	path, err := m.staging.tempFile(importPattern)
+	if err != nil {
+		return "", 0, nil, nil, nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		_ = os.Remove(path)
+		return "", 0, nil, nil, nil, fmt.Errorf("error when opening batch file: %s", err)
+	}
+	sw := &stagingWriter{s: m.staging, w: f}
+	h := sha256.New()
+	cw := &countingWriter{w: io.MultiWriter(sw, h)}
+	release := func() {
+		_ = os.Remove(path)
+		m.staging.release(sw.written)
+	}
+	entries, err := writeBatch(cw, br, m.staging)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		release()
+		if err == ErrUploadTooLarge || err == ErrDiskQuotaExceeded {
+			return "", 0, nil, nil, nil, err
+		}
+		return "", 0, nil, nil, nil, fmt.Errorf("error when packing batch: %s", err)
+	}
+	return path, uint64(cw.n), h.Sum(nil), entries, release, nil
+}
+
+// writeBatch writes the files of br as a tar archive to cw. Every file is
+// staged first, since tar headers need its size.
+func writeBatch(cw *countingWriter, br BatchReader, s *staging) ([]BatchEntry, error) {
+	tw := tar.NewWriter(cw)
+	var entries []BatchEntry
+	names := make(map[string]struct{})
+	for {
+		bf, err := br.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if bf.Name == "" {
+			return nil, fmt.Errorf("batch file name can't be empty")
+		}
+		if _, ok := names[bf.Name]; ok {
+			return nil, fmt.Errorf("batch file %q is duplicated", bf.Name)
+		}
+		names[bf.Name] = struct{}{}
+		entry, err := writeBatchFile(tw, cw, bf, s)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("batch should contain at least one file")
+	}
+	return entries, tw.Close()
+}
+
+// writeBatchFile stages bf and appends it to the archive of tw
+func writeBatchFile(tw *tar.Writer, cw *countingWriter, bf BatchFile, s *staging) (BatchEntry, error) {
+	path, size, release, err := s.stage(bf.Data)
+	if err != nil {
+		return BatchEntry{}, err
+	}
+	defer release()
+	f, err := os.Open(path)
+	if err != nil {
+		return BatchEntry{}, err
+	}
+	defer f.Close()
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     bf.Name,
+		Mode:     0644,
+		Size:     int64(size),
+		ModTime:  time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return BatchEntry{}, err
+	}
+	// the header is flushed, so the data starts at the current position
+	entry := BatchEntry{Name: bf.Name, Offset: uint64(cw.n), Size: size}
+	if _, err := io.Copy(tw, f); err != nil {
+		return BatchEntry{}, err
+	}
+	return entry, nil
+}
+
+// newBatch returns a Batch of entries, identified by the sha256 digest of its
+// archive so batches with different contents never share a cid
+func newBatch(size uint64, digest []byte, entries []BatchEntry) (Batch, error) {
+	mh, err := multihash.Encode(digest, multihash.SHA2_256)
+	if err != nil {
+		return Batch{}, fmt.Errorf("error when generating batch cid: %s", err)
+	}
+	c := cid.NewCidV1(cid.Raw, mh)
+	return Batch{
+		Cid:       c,
+		Size:      size,
+		Files:     entries,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// countingWriter counts the bytes written to w
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// sectionReadCloser reads a section of a file, closing it when done
+type sectionReadCloser struct {
+	io.Reader
+	io.Closer
+}
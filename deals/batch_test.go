@@ -0,0 +1,200 @@
+package deals
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	pb "github.com/textileio/filecoin/deals/pb"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestStoreBatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "batch")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+	api := newMockAPI()
+	api.offers = []types.QueryOffer{{Miner: "t01", MinPrice: types.NewInt(0)}}
+	s, err := newStaging(Config{ImportPath: dir})
+	checkErr(t, err)
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store), staging: s}
+
+	contents := map[string][]byte{
+		"a.txt":     []byte("first file"),
+		"dir/b.txt": bytes.Repeat([]byte{1}, 1000),
+		"c.txt":     []byte("third"),
+	}
+	files := []BatchFile{
+		{Name: "a.txt", Data: bytes.NewReader(contents["a.txt"])},
+		{Name: "dir/b.txt", Data: bytes.NewReader(contents["dir/b.txt"])},
+		{Name: "c.txt", Data: bytes.NewReader(contents["c.txt"])},
+	}
+	dealConfigs := []DealConfig{{Miner: "t01", EpochPrice: types.NewInt(10)}}
+	b, proposals, failed, err := m.StoreBatch(ctx, "t3addr", NewBatchReader(files), dealConfigs, 100)
+	checkErr(t, err)
+	if len(proposals) != 1 || len(failed) != 0 {
+		t.Fatalf("expected 1 proposal, got %d and %d failures", len(proposals), len(failed))
+	}
+	if len(b.Files) != 3 {
+		t.Fatalf("expected 3 files in batch, got %v", b.Files)
+	}
+	dr, err := store.get(proposals[0])
+	checkErr(t, err)
+	if !dr.BatchCid.Equals(b.Cid) || !dr.DataCid.Equals(b.DataCid) {
+		t.Fatalf("deal record doesn't reference the batch: %v", dr)
+	}
+	saved, err := m.GetBatch(b.Cid)
+	checkErr(t, err)
+	if !saved.DataCid.Equals(b.DataCid) {
+		t.Fatalf("unexpected saved batch %v", saved)
+	}
+
+	for name, content := range contents {
+		rc, err := m.RetrieveFile(ctx, "t3addr", b.Cid, name)
+		checkErr(t, err)
+		got, err := ioutil.ReadAll(rc)
+		checkErr(t, err)
+		checkErr(t, rc.Close())
+		if !bytes.Equal(content, got) {
+			t.Fatalf("retrieved %s doesn't match original", name)
+		}
+	}
+	if _, err := m.RetrieveFile(ctx, "t3addr", b.Cid, "missing"); err != ErrFileNotFound {
+		t.Fatalf("expected ErrFileNotFound, got %v", err)
+	}
+
+	// The stored data is a regular tar archive
+	rc, err := m.Retrieve(ctx, "t3addr", b.DataCid)
+	checkErr(t, err)
+	defer rc.Close()
+	tr := tar.NewReader(rc)
+	for _, f := range b.Files {
+		hdr, err := tr.Next()
+		checkErr(t, err)
+		if hdr.Name != f.Name || uint64(hdr.Size) != f.Size {
+			t.Fatalf("unexpected archive entry %s of size %d", hdr.Name, hdr.Size)
+		}
+	}
+}
+
+func TestStoreBatchSameLayout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "batch")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+	api := newMockAPI()
+	api.offers = []types.QueryOffer{{Miner: "t01", MinPrice: types.NewInt(0)}}
+	s, err := newStaging(Config{ImportPath: dir})
+	checkErr(t, err)
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store), staging: s}
+
+	dealConfigs := []DealConfig{{Miner: "t01", EpochPrice: types.NewInt(10)}}
+	contents := []string{"hello", "world"}
+	batches := make([]Batch, len(contents))
+	for i, content := range contents {
+		files := []BatchFile{{Name: "a.txt", Data: strings.NewReader(content)}}
+		batches[i], _, _, err = m.StoreBatch(ctx, "t3addr", NewBatchReader(files), dealConfigs, 100)
+		checkErr(t, err)
+	}
+	if batches[0].Cid.Equals(batches[1].Cid) {
+		t.Fatalf("batches with different contents share cid %s", batches[0].Cid)
+	}
+	for i, content := range contents {
+		rc, err := m.RetrieveFile(ctx, "t3addr", batches[i].Cid, "a.txt")
+		checkErr(t, err)
+		got, err := ioutil.ReadAll(rc)
+		checkErr(t, err)
+		checkErr(t, rc.Close())
+		if string(got) != content {
+			t.Fatalf("expected %q from batch %d, got %q", content, i, got)
+		}
+	}
+}
+
+func TestStoreBatchDuplicatedName(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "batch")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+	s, err := newStaging(Config{ImportPath: dir})
+	checkErr(t, err)
+	m := &Module{api: newMockAPI(), staging: s}
+
+	files := []BatchFile{
+		{Name: "a.txt", Data: bytes.NewReader([]byte("1"))},
+		{Name: "a.txt", Data: bytes.NewReader([]byte("2"))},
+	}
+	if _, _, _, err := m.StoreBatch(context.Background(), "t3addr", NewBatchReader(files), nil, 100); err == nil {
+		t.Fatal("expected an error for duplicated file names")
+	}
+	left, err := ioutil.ReadDir(dir)
+	checkErr(t, err)
+	if len(left) != 0 {
+		t.Fatalf("expected staged files to be removed, found %d", len(left))
+	}
+}
+
+type fakeStoreBatchServer struct {
+	pb.API_StoreBatchServer
+	reqs []*pb.StoreBatchRequest
+}
+
+func (s *fakeStoreBatchServer) Recv() (*pb.StoreBatchRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func TestBatchStream(t *testing.T) {
+	t.Parallel()
+	file := func(name string) *pb.StoreBatchRequest {
+		return &pb.StoreBatchRequest{Payload: &pb.StoreBatchRequest_File{File: &pb.BatchFileHeader{Name: name}}}
+	}
+	chunk := func(data string) *pb.StoreBatchRequest {
+		return &pb.StoreBatchRequest{Payload: &pb.StoreBatchRequest_Chunk{Chunk: []byte(data)}}
+	}
+	bs := &batchStream{srv: &fakeStoreBatchServer{reqs: []*pb.StoreBatchRequest{
+		file("a"), chunk("hello "), chunk("world"),
+		file("empty"),
+		file("b"), chunk("bye"),
+	}}}
+
+	expected := []struct{ name, data string }{{"a", "hello world"}, {"empty", ""}, {"b", "bye"}}
+	for i, e := range expected {
+		bf, err := bs.Next()
+		checkErr(t, err)
+		if bf.Name != e.name {
+			t.Fatalf("expected file %s, got %s", e.name, bf.Name)
+		}
+		// the data of the second file isn't read, to check it's skipped
+		if i == 0 || i == 2 {
+			got, err := ioutil.ReadAll(bf.Data)
+			checkErr(t, err)
+			if string(got) != e.data {
+				t.Fatalf("expected %q for %s, got %q", e.data, e.name, got)
+			}
+		}
+	}
+	if _, err := bs.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+
+	bs = &batchStream{srv: &fakeStoreBatchServer{reqs: []*pb.StoreBatchRequest{chunk("orphan")}}}
+	if _, err := bs.Next(); err == nil {
+		t.Fatal("expected an error for a chunk without file header")
+	}
+}
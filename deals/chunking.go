@@ -0,0 +1,168 @@
+package deals
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+)
+
+// Manifest links the pieces of data that was split by Store, so it can be
+// reassembled on retrieval
+type Manifest struct {
+	Cid       cid.Cid
+	Size      uint64
+	Pieces    []Piece
+	CreatedAt time.Time
+}
+
+// Piece is a part of data stored with its own deals
+type Piece struct {
+	DataCid cid.Cid
+	Offset  uint64
+	Size    uint64
+}
+
+// WithChunkSize enables splitting data larger than size into pieces of at
+// most size bytes, which are proposed independently
+func WithChunkSize(size uint64) StoreOption {
+	return func(c *StoreConfig) {
+		c.ChunkSize = size
+	}
+}
+
+// GetManifest returns the Manifest of data split by Store
+func (m *Module) GetManifest(c cid.Cid) (Manifest, error) {
+	return m.store.getManifest(c)
+}
+
+// storeChunks imports the staged file in path as pieces of chunkSize, persists
+// a Manifest linking them, and proposes deals for every piece completing base.
+// It returns the cid of the Manifest.
+func (m *Module) storeChunks(ctx context.Context, base DealRecord, path string, size uint64, chunkSize uint64, dealConfigs []DealConfig) (cid.Cid, []cid.Cid, []FailedDeal, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return cid.Undef, nil, nil, fmt.Errorf("error when opening staged file: %s", err)
+	}
+	defer f.Close()
+
+	var pieces []Piece
+	for offset := uint64(0); offset < size; offset += chunkSize {
+		n := chunkSize
+		if offset+n > size {
+			n = size - offset
+		}
+		dataCid, err := m.importPiece(ctx, io.NewSectionReader(f, int64(offset), int64(n)))
+		if err != nil {
+			return cid.Undef, nil, nil, err
+		}
+		pieces = append(pieces, Piece{DataCid: dataCid, Offset: offset, Size: n})
+	}
+	mf, err := newManifest(size, pieces)
+	if err != nil {
+		return cid.Undef, nil, nil, err
+	}
+	if err := m.store.putManifest(mf); err != nil {
+		return cid.Undef, nil, nil, fmt.Errorf("error when saving manifest: %s", err)
+	}
+
+	var proposals []cid.Cid
+	var failed []FailedDeal
+	base.ManifestCid = mf.Cid
+	for _, p := range pieces {
+		base.DataCid = p.DataCid
+		pp, pf := m.proposeDeals(ctx, base, dealConfigs)
+		proposals = append(proposals, pp...)
+		failed = append(failed, pf...)
+	}
+	m.tracker.requestRefresh()
+	return mf.Cid, proposals, failed, nil
+}
+
+// importPiece stages a piece of data and imports it in the Filecoin full-node.
+// The piece is part of a file that's already staged, so it isn't accounted
+// again in the disk quota.
+func (m *Module) importPiece(ctx context.Context, data io.Reader) (cid.Cid, error) {
+	path, release, err := m.staging.stageCopy(data)
+	if err != nil {
+		return cid.Undef, err
+	}
+	defer release()
+	dataCid, err := m.api.ClientImport(ctx, path)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("error when importing piece: %s", err)
+	}
+	return dataCid, nil
+}
+
+// retrieveManifest retrieves every piece of mf and concatenates them into a
+// temporary file
+func (m *Module) retrieveManifest(ctx context.Context, addr string, mf Manifest) (*tmpFile, error) {
+	path, err := m.staging.tempFile(retrievePattern)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when opening reassembly file: %s", err)
+	}
+	for _, p := range mf.Pieces {
+		if err := m.retrievePiece(ctx, addr, p, f); err != nil {
+			_ = f.Close()
+			_ = os.Remove(path)
+			return nil, err
+		}
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when closing reassembly file: %s", err)
+	}
+	r, err := os.Open(path)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when opening reassembled file: %s", err)
+	}
+	return &tmpFile{File: r}, nil
+}
+
+// retrievePiece retrieves a piece and writes it to w
+func (m *Module) retrievePiece(ctx context.Context, addr string, p Piece, w io.Writer) error {
+	rc, err := m.retrieveData(ctx, addr, p.DataCid)
+	if err != nil {
+		return fmt.Errorf("error when retrieving piece %s: %s", p.DataCid, err)
+	}
+	defer rc.Close()
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		return fmt.Errorf("error when copying piece %s: %s", p.DataCid, err)
+	}
+	if uint64(n) != p.Size {
+		return fmt.Errorf("piece %s has size %d, expected %d", p.DataCid, n, p.Size)
+	}
+	return nil
+}
+
+// newManifest returns a Manifest of pieces, identified by the hash of its
+// pieces
+func newManifest(size uint64, pieces []Piece) (Manifest, error) {
+	b, err := json.Marshal(pieces)
+	if err != nil {
+		return Manifest{}, err
+	}
+	c, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum(b)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("error when generating manifest cid: %s", err)
+	}
+	return Manifest{
+		Cid:       c,
+		Size:      size,
+		Pieces:    pieces,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestStoreChunks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "chunking")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+
+	api := newMockAPI()
+	api.offers = []types.QueryOffer{{Miner: "t01", MinPrice: types.NewInt(0)}}
+	// pieces of the staged data don't count in the quota again
+	s, err := newStaging(Config{ImportPath: dir, ImportDiskQuota: 250})
+	checkErr(t, err)
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store), staging: s}
+
+	data := make([]byte, 250)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	path, size, release, err := s.stage(bytes.NewReader(data))
+	checkErr(t, err)
+	defer release()
+	dealConfigs := []DealConfig{{Miner: "t01", EpochPrice: types.NewInt(10)}}
+	mfCid, proposals, failed, err := m.storeChunks(ctx, DealRecord{Addr: "t3addr", Duration: 100}, path, size, 100, dealConfigs)
+	checkErr(t, err)
+	if len(proposals) != 3 || len(failed) != 0 {
+		t.Fatalf("expected 3 proposals, got %d and %d failures", len(proposals), len(failed))
+	}
+
+	dr, err := store.get(proposals[0])
+	checkErr(t, err)
+	if !dr.ManifestCid.Equals(mfCid) {
+		t.Fatalf("deal manifest %s doesn't match %s", dr.ManifestCid, mfCid)
+	}
+	mf, err := m.GetManifest(mfCid)
+	checkErr(t, err)
+	if mf.Size != 250 || len(mf.Pieces) != 3 || mf.Pieces[2].Offset != 200 || mf.Pieces[2].Size != 50 {
+		t.Fatalf("unexpected manifest %v", mf)
+	}
+
+	rc, err := m.Retrieve(ctx, "t3addr", mf.Cid)
+	checkErr(t, err)
+	defer rc.Close()
+	got, err := ioutil.ReadAll(rc)
+	checkErr(t, err)
+	if !bytes.Equal(data, got) {
+		t.Fatalf("reassembled data doesn't match original")
+	}
+}
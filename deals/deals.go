@@ -4,32 +4,50 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"reflect"
+	"sync"
 	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log"
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/index/miner"
+	"github.com/textileio/filecoin/index/slashing"
 	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/reputation"
+	"github.com/textileio/filecoin/wallet"
 )
 
 const (
 	initialWait      = time.Second * 5
 	chanWriteTimeout = time.Second
+	startDealTimeout = time.Minute
 )
 
 var (
+	trackInterval = time.Second * 30
+
 	log = logging.Logger("deals")
 )
 
 // Module exposes storage, monitoring, and Asks from the market.
 type Module struct {
-	api            API
-	ds             datastore.Datastore
-	basePathImport string
+	api           API
+	askIndex      *ask.AskIndex
+	minerIndex    *miner.MinerIndex
+	slashingIndex *slashing.SlashingIndex
+	reputation    *reputation.ReputationModule
+	wallet        *wallet.Module
+	store         *dealStore
+	tracker       *tracker
+	staging       *staging
+
+	ctx              context.Context
+	cancel           context.CancelFunc
+	finished         chan struct{}
+	webhooksFinished chan struct{}
+	clsLock          sync.Mutex
+	closed           bool
 }
 
 // DealConfig contains information about a proposal for a particular miner
@@ -50,6 +68,37 @@ type DealInfo struct {
 
 	PricePerEpoch types.BigInt
 	Duration      uint64
+
+	RenewedBy cid.Cid
+	UpdatedAt time.Time
+}
+
+// DealRecord contains information about a proposal created by Store, and the
+// last known state of the deal
+type DealRecord struct {
+	ProposalCid cid.Cid
+	DataCid     cid.Cid
+	ManifestCid cid.Cid
+	BatchCid    cid.Cid
+	Addr        string
+	Miner       string
+	EpochPrice  types.BigInt
+	Duration    uint64
+	CreatedAt   time.Time
+
+	RetryPolicy *RetryPolicy
+	Attempt     int
+	RetryOf     cid.Cid
+	Retried     bool
+
+	RenewalPolicy   *RenewalPolicy
+	ActivationEpoch uint64
+	RenewalOf       cid.Cid
+	RenewedBy       cid.Cid
+
+	Encryption *EncryptionParams
+
+	Info DealInfo
 }
 
 // API interacts with a Filecoin full-node
@@ -57,83 +106,225 @@ type API interface {
 	ClientStartDeal(ctx context.Context, data cid.Cid, addr string, miner string, epochPrice types.BigInt, blocksDuration uint64) (*cid.Cid, error)
 	ClientImport(ctx context.Context, path string) (cid.Cid, error)
 	ClientGetDealInfo(context.Context, cid.Cid) (*types.DealInfo, error)
+	ClientFindData(ctx context.Context, root cid.Cid) ([]types.QueryOffer, error)
+	ClientRetrieve(ctx context.Context, order types.RetrievalOrder, path string) error
 	ChainNotify(context.Context) (<-chan []*types.HeadChange, error)
+	ChainHead(context.Context) (*types.TipSet, error)
+}
+
+// Config contains settings for the deals Module
+type Config struct {
+	// ImportPath is the folder where data is staged for the Filecoin
+	// full-node. If empty, a folder in the home directory is used.
+	ImportPath string
+	// MaxUploadSize is the maximum size in bytes of data to store. Zero
+	// means unlimited.
+	MaxUploadSize int64
+	// ImportDiskQuota is the maximum total size in bytes of data being staged
+	// at the same time. Zero means unlimited.
+	ImportDiskQuota int64
 }
 
-// New creates a new deal module
-func New(ds datastore.Datastore, api API) *Module {
-	// can't avoid home base path, ipfs checks: cannot add filestore references outside ipfs root (home folder)
-	home, err := os.UserHomeDir()
+// New creates a new deal module. It immediately starts tracking the state of
+// persisted deals. ai, mi and si are used to select miners when retrying
+// failed deals or in StoreAuto, and can be nil if those aren't used. rm is
+// used to filter miners by reputation in storage profiles, and can be nil too.
+// If wm isn't nil, Store checks that the paying wallet can cover the deals.
+func New(ds datastore.TxnDatastore, api API, ai *ask.AskIndex, mi *miner.MinerIndex, si *slashing.SlashingIndex, rm *reputation.ReputationModule, wm *wallet.Module, conf Config) (*Module, error) {
+	staging, err := newStaging(conf)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	store := newDealStore(ds)
 	dm := &Module{
-		api:            api,
-		ds:             ds,
-		basePathImport: filepath.Join(home, "textilefc"),
+		api:              api,
+		askIndex:         ai,
+		minerIndex:       mi,
+		slashingIndex:    si,
+		reputation:       rm,
+		wallet:           wm,
+		store:            store,
+		tracker:          newTracker(api, store),
+		staging:          staging,
+		ctx:              ctx,
+		cancel:           cancel,
+		finished:         make(chan struct{}),
+		webhooksFinished: make(chan struct{}),
 	}
-	return dm
+	go dm.run()
+	go dm.dispatchWebhooks(dm.tracker.subscribeAll())
+	return dm, nil
 }
 
 // Store creates a proposal deal for data using wallet addr to all miners indicated
-// by dealConfigs for duration epochs
-func (m *Module) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []DealConfig, duration uint64) ([]cid.Cid, []DealConfig, error) {
-	tmpF, err := ioutil.TempFile(m.basePathImport, "import-*")
+// by dealConfigs for duration epochs. DealConfigs for which no deal could be
+// proposed are returned as FailedDeals with the reason of the failure. If a
+// chunk size is configured, data larger than it is split into pieces that are
+// proposed independently. If encryption is enabled, data is encrypted before
+// being imported.
+func (m *Module) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []DealConfig, duration uint64, opts ...StoreOption) ([]cid.Cid, []FailedDeal, error) {
+	path, size, release, err := m.staging.stage(data)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error when creating tmpfile: %s", err)
+		return nil, nil, err
+	}
+	defer release()
+	_, proposals, failed, err := m.storeStaged(ctx, DealRecord{Addr: addr, Duration: duration}, path, size, dealConfigs, opts...)
+	return proposals, failed, err
+}
+
+// storeStaged imports the staged file in path, and proposes deals for it with
+// all miners indicated by dealConfigs completing base. It returns the cid to
+// retrieve the data, which is the cid of a Manifest if it was split in pieces.
+func (m *Module) storeStaged(ctx context.Context, base DealRecord, path string, size uint64, dealConfigs []DealConfig, opts ...StoreOption) (cid.Cid, []cid.Cid, []FailedDeal, error) {
+	var config StoreConfig
+	for _, opt := range opts {
+		opt(&config)
 	}
-	defer os.Remove(tmpF.Name())
-	defer tmpF.Close()
-	if _, err := io.Copy(tmpF, data); err != nil {
-		return nil, nil, fmt.Errorf("error when copying data to tmpfile: %s", err)
+	if config.RetryPolicy != nil && config.RetryPolicy.ReplicationFactor == 0 {
+		config.RetryPolicy.ReplicationFactor = len(dealConfigs)
 	}
-	dataCid, err := m.api.ClientImport(ctx, tmpF.Name())
+	base.RetryPolicy = config.RetryPolicy
+	base.RenewalPolicy = config.RenewalPolicy
+
+	if config.Encryption != nil {
+		key, params, err := newEncryption(config.Encryption)
+		if err != nil {
+			return cid.Undef, nil, nil, err
+		}
+		encPath, encSize, release, err := m.stageEncrypted(path, key, params.IV)
+		if err != nil {
+			return cid.Undef, nil, nil, err
+		}
+		defer release()
+		path, size = encPath, encSize
+		base.Encryption = &params
+	}
+	if err := m.checkFunds(ctx, base.Addr, size, base.Duration, dealConfigs); err != nil {
+		return cid.Undef, nil, nil, err
+	}
+	if config.ChunkSize > 0 && size > config.ChunkSize {
+		return m.storeChunks(ctx, base, path, size, config.ChunkSize, dealConfigs)
+	}
+	dataCid, err := m.api.ClientImport(ctx, path)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error when importing data: %s", err)
+		return cid.Undef, nil, nil, fmt.Errorf("error when importing data: %s", err)
 	}
+	base.DataCid = dataCid
+	proposals, failed := m.proposeDeals(ctx, base, dealConfigs)
+	m.tracker.requestRefresh()
+	return dataCid, proposals, failed, nil
+}
 
+// proposeDeals creates a proposal deal for the imported data of base with all
+// miners indicated by dealConfigs, and persists a DealRecord for each of them
+// completing base.
+func (m *Module) proposeDeals(ctx context.Context, base DealRecord, dealConfigs []DealConfig) ([]cid.Cid, []FailedDeal) {
 	var proposals []cid.Cid
-	var failed []DealConfig
+	var failed []FailedDeal
 	for _, dconfig := range dealConfigs {
-		if err != nil {
-			log.Errorf("miner addr is invalid %v: %s", dconfig, err)
-			failed = append(failed, dconfig)
+		if fd, ok := m.checkDealConfig(dconfig); !ok {
+			log.Errorf("skipping deal with %v: %s", dconfig, fd.Message)
+			failed = append(failed, fd)
 			continue
 		}
-		proposal, err := m.api.ClientStartDeal(ctx, dataCid, addr, dconfig.Miner, dconfig.EpochPrice, duration)
+		proposal, err := m.startDeal(ctx, base.DataCid, base.Addr, dconfig, base.Duration)
 		if err != nil {
 			log.Errorf("error when starting deal with %v: %s", dconfig, err)
-			failed = append(failed, dconfig)
+			failed = append(failed, m.failedDeal(dconfig, err))
 			continue
 		}
+		dr := base
+		dr.ProposalCid = *proposal
+		dr.Miner = dconfig.Miner
+		dr.EpochPrice = dconfig.EpochPrice
+		dr.CreatedAt = time.Now()
+		dr.Info = newDealInfo(*proposal, dconfig.Miner, dconfig.EpochPrice, base.Duration)
+		if err := m.store.put(dr); err != nil {
+			log.Errorf("error when saving deal record %s: %s", proposal, err)
+		}
 		proposals = append(proposals, *proposal)
 	}
-	return proposals, failed, nil
+	return proposals, failed
+}
+
+// ListDeals returns all the deals created by Store
+func (m *Module) ListDeals() ([]DealRecord, error) {
+	return m.store.getAll()
+}
+
+// GetDeal returns the deal created by Store with the indicated proposal cid
+func (m *Module) GetDeal(proposal cid.Cid) (DealRecord, error) {
+	return m.store.get(proposal)
 }
 
-// Watch returnas a channel with state changes of indicated proposals
-func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan DealInfo, error) {
-	ch := make(chan DealInfo)
-	w, err := m.api.ChainNotify(ctx)
+// Watch returns a channel with state changes of indicated proposals. Every
+// recorded state change that happened after since is replayed before any new
+// one is sent, so a zero since replays the full history. Options can filter
+// the sent changes, and close the channel once every proposal is done.
+func (m *Module) Watch(ctx context.Context, proposals []cid.Cid, since time.Time, opts ...WatchOption) (<-chan WatchEvent, error) {
+	var config WatchConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	ws := newWatchState(proposals, config)
+	sub := m.tracker.subscribe(proposals)
+	replay, err := m.store.getTransitions(proposals, since)
 	if err != nil {
-		return nil, fmt.Errorf("error when listening to chain changes: %s", err)
+		m.tracker.unsubscribe(sub)
+		return nil, fmt.Errorf("error when getting deal transitions: %s", err)
 	}
+	for _, p := range proposals {
+		if dr, err := m.store.get(p); err == nil && !dr.Info.UpdatedAt.IsZero() && !dr.Info.UpdatedAt.After(since) {
+			// the current state is older than the replay
+			ws.last[p] = dr.Info
+		}
+	}
+	ch := make(chan WatchEvent)
 	go func() {
 		defer close(ch)
+		defer m.tracker.unsubscribe(sub)
+
+		send := func(di DealInfo) bool {
+			if !ws.update(di) {
+				return true
+			}
+			select {
+			case <-ctx.Done():
+				return false
+			case ch <- WatchEvent{Deal: di}:
+				return true
+			}
+		}
+		finish := func() bool {
+			if !config.UntilDone || !ws.done() {
+				return false
+			}
+			select {
+			case <-ctx.Done():
+			case ch <- WatchEvent{Summary: ws.summary()}:
+			}
+			return true
+		}
 
-		currentState := make(map[cid.Cid]types.DealInfo)
-		tout := time.After(initialWait)
+		for _, di := range replay {
+			if !send(di) {
+				return
+			}
+		}
+		if finish() {
+			return
+		}
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-tout:
-				if err := m.pushNewChanges(ctx, currentState, proposals, ch); err != nil {
-					log.Errorf("error when pushing new proposal states: %s", err)
+			case di, ok := <-sub.ch:
+				if !ok {
+					return
 				}
-			case <-w:
-				if err := m.pushNewChanges(ctx, currentState, proposals, ch); err != nil {
-					log.Errorf("error when pushing new proposal states: %s", err)
+				if !send(di) || finish() {
+					return
 				}
 			}
 		}
@@ -141,33 +332,62 @@ func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan DealInf
 	return ch, nil
 }
 
-func (m *Module) pushNewChanges(ctx context.Context, currState map[cid.Cid]types.DealInfo, proposals []cid.Cid, ch chan<- DealInfo) error {
-	for _, pcid := range proposals {
-		dinfo, err := m.api.ClientGetDealInfo(ctx, pcid)
-		if err != nil {
-			log.Errorf("error when getting deal proposal info %s: %s", pcid, err)
-			continue
-		}
-		if !reflect.DeepEqual(currState[pcid], dinfo) {
-			currState[pcid] = *dinfo
-			newState := DealInfo{
-				ProposalCid:   dinfo.ProposalCid,
-				StateID:       dinfo.State,
-				StateName:     types.DealStates[dinfo.State],
-				Miner:         dinfo.Provider,
-				PieceRef:      dinfo.PieceRef,
-				Size:          dinfo.Size,
-				PricePerEpoch: dinfo.PricePerEpoch,
-				Duration:      dinfo.Duration,
-			}
-			select {
-			case <-ctx.Done():
-				return nil
-			case ch <- newState:
-			case <-time.After(chanWriteTimeout):
-				log.Warnf("dropping new state since chan is blocked")
+// run is a long running job that polls deal states whenever the chain head
+// changes, a refresh is requested, or every trackInterval. After every poll,
+// failed deals are retried and expiring deals are renewed if needed.
+func (m *Module) run() {
+	defer close(m.finished)
+	defer m.tracker.close()
+	n, err := m.api.ChainNotify(m.ctx)
+	if err != nil {
+		log.Errorf("error when listening to chain changes, falling back to polling: %s", err)
+	}
+	tout := time.After(initialWait)
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("graceful shutdown of deal tracker")
+			return
+		case <-tout:
+			tout = time.After(trackInterval)
+		case <-m.tracker.refresh:
+		case _, ok := <-n:
+			if !ok {
+				log.Warn("lotus notify channel closed, falling back to polling")
+				n = nil
+				continue
 			}
 		}
+		m.tracker.poll(m.ctx)
+		m.retryFailedDeals(m.ctx)
+		m.renewDeals(m.ctx)
+	}
+}
+
+// Close stops tracking deal states and delivering webhooks, and closes any
+// active Watch channel
+func (m *Module) Close() error {
+	log.Info("Closing")
+	m.clsLock.Lock()
+	defer m.clsLock.Unlock()
+	if m.closed {
+		return nil
 	}
+	m.cancel()
+	<-m.finished
+	<-m.webhooksFinished
+	m.closed = true
 	return nil
 }
+
+// newDealInfo returns the initial DealInfo of a new proposal
+func newDealInfo(proposal cid.Cid, miner string, epochPrice types.BigInt, duration uint64) DealInfo {
+	return DealInfo{
+		ProposalCid:   proposal,
+		StateID:       types.DealUnknown,
+		StateName:     types.DealStates[types.DealUnknown],
+		Miner:         miner,
+		PricePerEpoch: epochPrice,
+		Duration:      duration,
+	}
+}
@@ -0,0 +1,174 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multihash"
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+// mockAPI is an in-memory API implementation where deal states can be
+// modified at will
+type mockAPI struct {
+	lock     sync.Mutex
+	states   map[cid.Cid]types.DealInfo
+	offers   []types.QueryOffer
+	height   uint64
+	started  []string
+	startErr error
+	imported map[cid.Cid][]byte
+}
+
+var _ API = (*mockAPI)(nil)
+
+func newMockAPI() *mockAPI {
+	return &mockAPI{
+		states:   make(map[cid.Cid]types.DealInfo),
+		height:   100,
+		imported: make(map[cid.Cid][]byte),
+	}
+}
+
+func (a *mockAPI) setState(proposal cid.Cid, miner string, state types.DealState) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.states[proposal] = types.DealInfo{
+		ProposalCid:   proposal,
+		State:         state,
+		Provider:      miner,
+		PricePerEpoch: types.NewInt(10),
+		Duration:      100,
+	}
+}
+
+func (a *mockAPI) setHeight(height uint64) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.height = height
+}
+
+func (a *mockAPI) ClientStartDeal(ctx context.Context, data cid.Cid, addr string, miner string, epochPrice types.BigInt, blocksDuration uint64) (*cid.Cid, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.startErr != nil {
+		return nil, a.startErr
+	}
+	a.started = append(a.started, miner)
+	proposal, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(fmt.Sprintf("%s-%d", miner, len(a.started))))
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func (a *mockAPI) ClientImport(ctx context.Context, path string) (cid.Cid, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cid.Undef, err
+	}
+	dataCid, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum(b)
+	if err != nil {
+		return cid.Undef, err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.imported[dataCid] = b
+	return dataCid, nil
+}
+
+func (a *mockAPI) ClientGetDealInfo(ctx context.Context, proposal cid.Cid) (*types.DealInfo, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	di, ok := a.states[proposal]
+	if !ok {
+		return nil, fmt.Errorf("unknown proposal %s", proposal)
+	}
+	return &di, nil
+}
+
+func (a *mockAPI) ClientFindData(ctx context.Context, root cid.Cid) ([]types.QueryOffer, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	offers := make([]types.QueryOffer, len(a.offers))
+	for i, o := range a.offers {
+		o.Root = root
+		offers[i] = o
+	}
+	return offers, nil
+}
+
+func (a *mockAPI) ClientRetrieve(ctx context.Context, order types.RetrievalOrder, path string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	b, ok := a.imported[order.Root]
+	if !ok {
+		return fmt.Errorf("unknown data %s", order.Root)
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+func (a *mockAPI) ChainNotify(ctx context.Context) (<-chan []*types.HeadChange, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (a *mockAPI) ChainHead(ctx context.Context) (*types.TipSet, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return &types.TipSet{Height: a.height}, nil
+}
+
+// mockAskAPI is an ask.API that never refreshes the index, so it keeps the
+// asks loaded by newTestAskIndex
+type mockAskAPI struct{}
+
+var _ ask.API = mockAskAPI{}
+
+func (mockAskAPI) StateListMiners(context.Context, *types.TipSet) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (mockAskAPI) ClientQueryAsk(ctx context.Context, p peer.ID, miner string) (*types.SignedStorageAsk, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (mockAskAPI) StateMinerPeerID(ctx context.Context, m string, ts *types.TipSet) (peer.ID, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+func (mockAskAPI) ChainHead(context.Context) (*types.TipSet, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (mockAskAPI) StateMinerWorker(ctx context.Context, m string, ts *types.TipSet) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+func (mockAskAPI) WalletVerify(ctx context.Context, k string, msg []byte, sig *types.Signature) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+// newTestAskIndex returns an AskIndex with the provided asks, which must be
+// closed when done
+func newTestAskIndex(t *testing.T, asks ...ask.StorageAsk) *ask.AskIndex {
+	index := ask.Index{Storage: make(map[string]ask.StorageAsk)}
+	for _, sa := range asks {
+		index.Storage[sa.Miner] = sa
+	}
+	buf, err := cbor.DumpObject(index)
+	checkErr(t, err)
+	ds := tests.NewTxMapDatastore()
+	checkErr(t, ds.Put(datastore.NewKey("index"), buf))
+	ai, err := ask.New(ds, mockAskAPI{}, nil)
+	checkErr(t, err)
+	return ai
+}
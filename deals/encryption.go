@@ -0,0 +1,237 @@
+package deals
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// EncryptionAlgorithm is the algorithm used to encrypt data in Store
+	EncryptionAlgorithm = "aes-256-ctr-hmac-sha256"
+
+	keySize = 32
+	ivSize  = aes.BlockSize
+	macSize = sha256.Size
+)
+
+var (
+	// ErrDecryptionFailed returns when retrieved data can't be authenticated
+	// with the provided key
+	ErrDecryptionFailed = errors.New("data can't be decrypted with the provided key")
+)
+
+// EncryptionKey configures the encryption of data in Store, and receives the
+// key generated for the upload. If PublicKey is set, the key is only returned
+// wrapped with it.
+type EncryptionKey struct {
+	// PublicKey is an optional PKIX DER encoded RSA public key to wrap the key
+	PublicKey []byte
+	// Key is the generated key, set if PublicKey is empty
+	Key []byte
+	// WrappedKey is the generated key encrypted with RSA-OAEP using PublicKey
+	WrappedKey []byte
+}
+
+// EncryptionParams describes how data of a deal was encrypted
+type EncryptionParams struct {
+	Algorithm  string
+	IV         []byte
+	WrappedKey []byte
+}
+
+// WithEncryption enables encrypting data before importing it. The generated
+// key is set in ek when Store returns.
+func WithEncryption(ek *EncryptionKey) StoreOption {
+	return func(c *StoreConfig) {
+		c.Encryption = ek
+	}
+}
+
+// RetrieveConfig contains optional settings for Retrieve
+type RetrieveConfig struct {
+	Key []byte
+}
+
+// RetrieveOption modifies a RetrieveConfig
+type RetrieveOption func(*RetrieveConfig)
+
+// WithDecryptionKey decrypts retrieved data with key
+func WithDecryptionKey(key []byte) RetrieveOption {
+	return func(c *RetrieveConfig) {
+		c.Key = key
+	}
+}
+
+// UnwrapKey decrypts a key wrapped with the public key of priv
+func UnwrapKey(priv *rsa.PrivateKey, wrapped []byte) ([]byte, error) {
+	return rsa.DecryptOAEP(sha256.New(), rand.Reader, priv, wrapped, nil)
+}
+
+// newEncryption generates a key and IV for an upload, filling ek with the key
+// the caller gets back
+func newEncryption(ek *EncryptionKey) ([]byte, EncryptionParams, error) {
+	key := make([]byte, keySize)
+	iv := make([]byte, ivSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, EncryptionParams{}, fmt.Errorf("error when generating key: %s", err)
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, EncryptionParams{}, fmt.Errorf("error when generating iv: %s", err)
+	}
+	params := EncryptionParams{Algorithm: EncryptionAlgorithm, IV: iv}
+	if len(ek.PublicKey) == 0 {
+		ek.Key = key
+		return key, params, nil
+	}
+	pub, err := x509.ParsePKIXPublicKey(ek.PublicKey)
+	if err != nil {
+		return nil, EncryptionParams{}, fmt.Errorf("error when parsing public key: %s", err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, EncryptionParams{}, fmt.Errorf("public key should be an RSA key, got %T", pub)
+	}
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPub, key, nil)
+	if err != nil {
+		return nil, EncryptionParams{}, fmt.Errorf("error when wrapping key: %s", err)
+	}
+	ek.WrappedKey = wrapped
+	params.WrappedKey = wrapped
+	return key, params, nil
+}
+
+// deriveKeys returns the cipher and MAC keys derived from key
+func deriveKeys(key []byte) ([]byte, []byte) {
+	derive := func(label string) []byte {
+		h := hmac.New(sha256.New, key)
+		_, _ = h.Write([]byte(label))
+		return h.Sum(nil)
+	}
+	return derive("encryption"), derive("authentication")
+}
+
+// encrypt writes the IV, the encrypted data and a MAC of both to w
+func encrypt(key, iv []byte, data io.Reader, w io.Writer) error {
+	encKey, macKey := deriveKeys(key)
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, macKey)
+	out := io.MultiWriter(w, mac)
+	if _, err := out.Write(iv); err != nil {
+		return err
+	}
+	sw := cipher.StreamWriter{S: cipher.NewCTR(block, iv), W: out}
+	if _, err := io.Copy(sw, data); err != nil {
+		return err
+	}
+	_, err = w.Write(mac.Sum(nil))
+	return err
+}
+
+// decryptFile authenticates the encrypted file in path with key and writes
+// the decrypted data to w
+func decryptFile(key []byte, path string, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := fi.Size() - macSize
+	if size < ivSize {
+		return ErrDecryptionFailed
+	}
+
+	encKey, macKey := deriveKeys(key)
+	mac := hmac.New(sha256.New, macKey)
+	if _, err := io.Copy(mac, io.NewSectionReader(f, 0, size)); err != nil {
+		return err
+	}
+	tag := make([]byte, macSize)
+	if _, err := f.ReadAt(tag, size); err != nil {
+		return err
+	}
+	if !hmac.Equal(tag, mac.Sum(nil)) {
+		return ErrDecryptionFailed
+	}
+
+	iv := make([]byte, ivSize)
+	if _, err := f.ReadAt(iv, 0); err != nil {
+		return err
+	}
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return err
+	}
+	sr := cipher.StreamReader{S: cipher.NewCTR(block, iv), R: io.NewSectionReader(f, ivSize, size-ivSize)}
+	_, err = io.Copy(w, sr)
+	return err
+}
+
+// stageEncrypted encrypts the staged file in path into a new staged file. The
+// encryption overhead counts towards the disk quota, but not towards the
+// maximum upload size, which applies to the plaintext.
+func (m *Module) stageEncrypted(path string, key, iv []byte) (string, uint64, func(), error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("error when opening staged file: %s", err)
+	}
+	defer f.Close()
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(encrypt(key, iv, f, pw))
+	}()
+	encPath, size, release, err := m.staging.stageDerived(pr)
+	// unblocks the encryption if staging stopped early
+	_ = pr.Close()
+	if err != nil {
+		return "", 0, nil, err
+	}
+	return encPath, size, release, nil
+}
+
+// decryptRetrieved decrypts retrieved data with key into a new temporary file,
+// removing the retrieved copy
+func (m *Module) decryptRetrieved(key []byte, retrieved *tmpFile) (*tmpFile, error) {
+	defer retrieved.Close()
+	path, err := m.staging.tempFile(retrievePattern)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when opening decryption file: %s", err)
+	}
+	err = decryptFile(key, retrieved.Name(), f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		if err == ErrDecryptionFailed {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error when decrypting data: %s", err)
+	}
+	r, err := os.Open(path)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when opening decrypted file: %s", err)
+	}
+	return &tmpFile{File: r}, nil
+}
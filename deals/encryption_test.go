@@ -0,0 +1,115 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "encryption")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+
+	ek := &EncryptionKey{}
+	key, params, err := newEncryption(ek)
+	checkErr(t, err)
+	if !bytes.Equal(ek.Key, key) || params.Algorithm != EncryptionAlgorithm || len(params.IV) != ivSize {
+		t.Fatalf("unexpected encryption params %v", params)
+	}
+
+	data := []byte("sensitive data stored with miners")
+	var encrypted bytes.Buffer
+	checkErr(t, encrypt(key, params.IV, bytes.NewReader(data), &encrypted))
+	if bytes.Contains(encrypted.Bytes(), data) {
+		t.Fatalf("encrypted data contains plaintext")
+	}
+	path := filepath.Join(dir, "encrypted")
+	checkErr(t, ioutil.WriteFile(path, encrypted.Bytes(), 0644))
+
+	var decrypted bytes.Buffer
+	checkErr(t, decryptFile(key, path, &decrypted))
+	if !bytes.Equal(data, decrypted.Bytes()) {
+		t.Fatalf("decrypted data doesn't match original")
+	}
+
+	other := make([]byte, keySize)
+	if err := decryptFile(other, path, ioutil.Discard); err != ErrDecryptionFailed {
+		t.Fatalf("expected ErrDecryptionFailed with wrong key, got %v", err)
+	}
+	tampered := encrypted.Bytes()
+	tampered[ivSize] ^= 1
+	checkErr(t, ioutil.WriteFile(path, tampered, 0644))
+	if err := decryptFile(key, path, ioutil.Discard); err != ErrDecryptionFailed {
+		t.Fatalf("expected ErrDecryptionFailed with tampered data, got %v", err)
+	}
+}
+
+func TestWrapKey(t *testing.T) {
+	t.Parallel()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	checkErr(t, err)
+	pub, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	checkErr(t, err)
+
+	ek := &EncryptionKey{PublicKey: pub}
+	key, params, err := newEncryption(ek)
+	checkErr(t, err)
+	if ek.Key != nil || !bytes.Equal(ek.WrappedKey, params.WrappedKey) {
+		t.Fatalf("key should only be returned wrapped")
+	}
+	unwrapped, err := UnwrapKey(priv, ek.WrappedKey)
+	checkErr(t, err)
+	if !bytes.Equal(key, unwrapped) {
+		t.Fatalf("unwrapped key doesn't match generated key")
+	}
+}
+
+func TestStoreEncrypted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "encryption")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+
+	data := []byte("sensitive data stored with miners")
+	api := newMockAPI()
+	api.offers = []types.QueryOffer{{Miner: "t01", MinPrice: types.NewInt(0)}}
+	// the plaintext fits exactly, even if the ciphertext is larger
+	s, err := newStaging(Config{ImportPath: dir, MaxUploadSize: int64(len(data))})
+	checkErr(t, err)
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store), staging: s}
+
+	ek := &EncryptionKey{}
+	dealConfigs := []DealConfig{{Miner: "t01", EpochPrice: types.NewInt(10)}}
+	proposals, _, err := m.Store(ctx, "t3addr", bytes.NewReader(data), dealConfigs, 100, WithEncryption(ek))
+	checkErr(t, err)
+	if len(proposals) != 1 || len(ek.Key) != keySize {
+		t.Fatalf("expected a proposal and a key, got %d and %d", len(proposals), len(ek.Key))
+	}
+	dr, err := store.get(proposals[0])
+	checkErr(t, err)
+	if dr.Encryption == nil || dr.Encryption.Algorithm != EncryptionAlgorithm {
+		t.Fatalf("encryption params weren't recorded")
+	}
+
+	rc, err := m.Retrieve(ctx, "t3addr", dr.DataCid, WithDecryptionKey(ek.Key))
+	checkErr(t, err)
+	defer rc.Close()
+	got, err := ioutil.ReadAll(rc)
+	checkErr(t, err)
+	if !bytes.Equal(data, got) {
+		t.Fatalf("retrieved data doesn't match original")
+	}
+}
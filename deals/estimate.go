@@ -0,0 +1,86 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// gib is the size unit of ask prices
+const gib = 1 << 30
+
+// Estimation contains the expected cost of storing data
+type Estimation struct {
+	Total types.BigInt
+	Deals []DealEstimation
+}
+
+// DealEstimation contains the expected cost of a deal with a miner, and
+// warnings about conditions that could make the deal fail
+type DealEstimation struct {
+	Miner      string
+	EpochPrice types.BigInt
+	Cost       types.BigInt
+	Warnings   []string
+}
+
+// Estimate returns the cost in attoFIL of storing size bytes for duration
+// epochs with the miners indicated by dealConfigs, without proposing any deal.
+func (m *Module) Estimate(ctx context.Context, size uint64, duration uint64, dealConfigs []DealConfig) (Estimation, error) {
+	if m.askIndex == nil {
+		return Estimation{}, fmt.Errorf("estimation isn't available")
+	}
+	head, err := m.api.ChainHead(ctx)
+	if err != nil {
+		return Estimation{}, fmt.Errorf("error when getting chain head: %s", err)
+	}
+	index := m.askIndex.Get()
+	e := Estimation{
+		Total: requiredFunds(size, duration, dealConfigs),
+		Deals: make([]DealEstimation, len(dealConfigs)),
+	}
+	for i, dconfig := range dealConfigs {
+		de := DealEstimation{
+			Miner:      dconfig.Miner,
+			EpochPrice: dconfig.EpochPrice,
+			Cost:       dealCost(dconfig.EpochPrice, size, duration),
+		}
+		sa, ok := index.Storage[dconfig.Miner]
+		if !ok {
+			de.Warnings = append(de.Warnings, "miner has no known ask")
+		} else {
+			if sa.Expiry <= head.Height {
+				de.Warnings = append(de.Warnings, fmt.Sprintf("ask expired at epoch %d", sa.Expiry))
+			}
+			if sa.MinPieceSize > size {
+				de.Warnings = append(de.Warnings, fmt.Sprintf("data size is smaller than ask min piece size %d", sa.MinPieceSize))
+			}
+			if dconfig.EpochPrice.LessThan(types.NewInt(sa.Price)) {
+				de.Warnings = append(de.Warnings, fmt.Sprintf("epoch price is lower than ask price %d", sa.Price))
+			}
+		}
+		e.Deals[i] = de
+	}
+	return e, nil
+}
+
+// EstimateAuto returns the cost in attoFIL of storing size bytes for duration
+// epochs with the miners that StoreAuto would select for config.
+func (m *Module) EstimateAuto(ctx context.Context, size uint64, duration uint64, config AutoConfig) (Estimation, error) {
+	if config.PieceSize == 0 {
+		config.PieceSize = size
+	}
+	dealConfigs, err := m.selectDealConfigs(config)
+	if err != nil {
+		return Estimation{}, err
+	}
+	return m.Estimate(ctx, size, duration, dealConfigs)
+}
+
+// dealCost returns the cost of storing size bytes for duration epochs at
+// epochPrice per GiB, rounded up.
+func dealCost(epochPrice types.BigInt, size uint64, duration uint64) types.BigInt {
+	total := types.BigMul(types.BigMul(epochPrice, types.NewInt(size)), types.NewInt(duration))
+	return types.BigDiv(types.BigAdd(total, types.NewInt(gib-1)), types.NewInt(gib))
+}
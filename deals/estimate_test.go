@@ -0,0 +1,75 @@
+package deals
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+func TestDealCost(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		price    uint64
+		size     uint64
+		duration uint64
+		expect   uint64
+	}{
+		{name: "OneGiB", price: 10, size: gib, duration: 100, expect: 1000},
+		{name: "HalfGiB", price: 10, size: gib / 2, duration: 100, expect: 500},
+		{name: "RoundUp", price: 1, size: 1, duration: 1, expect: 1},
+		{name: "Free", price: 0, size: gib, duration: 100, expect: 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := dealCost(types.NewInt(tt.price), tt.size, tt.duration)
+			if !got.Equals(types.NewInt(tt.expect)) {
+				t.Fatalf("expected %d, got %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestEstimateWarnings(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t,
+		ask.StorageAsk{Miner: "t01", Price: 10, Expiry: 100},
+		ask.StorageAsk{Miner: "t02", Price: 10, Expiry: 200, MinPieceSize: 2000},
+		ask.StorageAsk{Miner: "t03", Price: 50, Expiry: 200},
+		ask.StorageAsk{Miner: "t04", Price: 10, Expiry: 200},
+	)
+	defer ai.Close()
+	// the chain head is at height 100
+	m := &Module{api: newMockAPI(), askIndex: ai}
+
+	expected := map[string]string{
+		"t01": "ask expired",
+		"t02": "min piece size",
+		"t03": "lower than ask price",
+		"t04": "",
+		"t05": "no known ask",
+	}
+	var dealConfigs []DealConfig
+	for _, miner := range []string{"t01", "t02", "t03", "t04", "t05"} {
+		dealConfigs = append(dealConfigs, DealConfig{Miner: miner, EpochPrice: types.NewInt(20)})
+	}
+	e, err := m.Estimate(context.Background(), 1000, 100, dealConfigs)
+	checkErr(t, err)
+	for _, de := range e.Deals {
+		warning := expected[de.Miner]
+		if warning == "" {
+			if len(de.Warnings) != 0 {
+				t.Errorf("unexpected warnings for %s: %v", de.Miner, de.Warnings)
+			}
+			continue
+		}
+		if len(de.Warnings) != 1 || !strings.Contains(de.Warnings[0], warning) {
+			t.Errorf("expected warning %q for %s, got %v", warning, de.Miner, de.Warnings)
+		}
+	}
+}
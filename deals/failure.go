@@ -0,0 +1,107 @@
+package deals
+
+import (
+	"context"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// FailureReason indicates why a deal proposal couldn't be made
+type FailureReason int
+
+const (
+	// FailureUnknown is an unclassified failure
+	FailureUnknown FailureReason = iota
+	// FailureInvalidAddress means the miner address isn't a valid address
+	FailureInvalidAddress
+	// FailureAskUnavailable means the Filecoin full-node failed to start the
+	// deal with a miner that has no storage ask in the ask index, so it's
+	// likely offline or not accepting deals
+	FailureAskUnavailable
+	// FailureRPCError means the Filecoin full-node failed to start the deal
+	FailureRPCError
+	// FailureTimeout means the Filecoin full-node didn't start the deal in time
+	FailureTimeout
+)
+
+var failureReasonNames = map[FailureReason]string{
+	FailureUnknown:        "unknown",
+	FailureInvalidAddress: "invalid address",
+	FailureAskUnavailable: "ask unavailable",
+	FailureRPCError:       "rpc error",
+	FailureTimeout:        "timeout",
+}
+
+func (r FailureReason) String() string {
+	if name, ok := failureReasonNames[r]; ok {
+		return name
+	}
+	return failureReasonNames[FailureUnknown]
+}
+
+// FailedDeal is a DealConfig for which no deal could be proposed, with the
+// reason of the failure
+type FailedDeal struct {
+	DealConfig
+	Reason  FailureReason
+	Message string
+}
+
+// newFailedDeal classifies err returned when proposing a deal for dconfig
+func newFailedDeal(dconfig DealConfig, err error) FailedDeal {
+	reason := FailureRPCError
+	if err == context.DeadlineExceeded {
+		reason = FailureTimeout
+	}
+	return FailedDeal{DealConfig: dconfig, Reason: reason, Message: err.Error()}
+}
+
+// failedDeal classifies err returned when proposing a deal for dconfig, telling
+// apart RPC errors of miners that have no known storage ask
+func (m *Module) failedDeal(dconfig DealConfig, err error) FailedDeal {
+	fd := newFailedDeal(dconfig, err)
+	if fd.Reason == FailureRPCError && !m.hasStorageAsk(dconfig.Miner) {
+		fd.Reason = FailureAskUnavailable
+	}
+	return fd
+}
+
+// hasStorageAsk returns false if miner is missing from a populated ask index
+func (m *Module) hasStorageAsk(miner string) bool {
+	if m.askIndex == nil {
+		return true
+	}
+	index := m.askIndex.Get()
+	// an empty index doesn't tell anything about miners
+	if len(index.Storage) == 0 {
+		return true
+	}
+	_, ok := index.Storage[miner]
+	return ok
+}
+
+// checkDealConfig verifies that a deal can be proposed for dconfig before
+// calling the Filecoin full-node, returning the FailedDeal otherwise. Miners
+// missing from the ask index are still proposed, since the index may not
+// include every miner.
+func (m *Module) checkDealConfig(dconfig DealConfig) (FailedDeal, bool) {
+	if _, err := types.AddressBytes(dconfig.Miner); err != nil {
+		return FailedDeal{DealConfig: dconfig, Reason: FailureInvalidAddress, Message: err.Error()}, false
+	}
+	return FailedDeal{}, true
+}
+
+// startDeal proposes a deal for dconfig, giving up after startDealTimeout
+func (m *Module) startDeal(ctx context.Context, dataCid cid.Cid, addr string, dconfig DealConfig, duration uint64) (*cid.Cid, error) {
+	ctx, cancel := context.WithTimeout(ctx, startDealTimeout)
+	defer cancel()
+	proposal, err := m.api.ClientStartDeal(ctx, dataCid, addr, dconfig.Miner, dconfig.EpochPrice, duration)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, context.DeadlineExceeded
+		}
+		return nil, err
+	}
+	return proposal, nil
+}
@@ -0,0 +1,62 @@
+package deals
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/textileio/filecoin/index/ask"
+)
+
+func TestCheckDealConfig(t *testing.T) {
+	t.Parallel()
+	m := &Module{}
+	valid := []string{"t01000", "f0100", "t3vvmn62lofvhjd2ugzca6sof2j2ubwok6cj4xxbfzz4yuxfkgobpihhd2thlanmsh3w2ptld2gqkn2jvlss4a"}
+	for _, addr := range valid {
+		if fd, ok := m.checkDealConfig(DealConfig{Miner: addr}); !ok {
+			t.Errorf("expected %s to be valid: %s", addr, fd.Message)
+		}
+	}
+	// the last one has a wrong checksum
+	invalid := []string{"", "t0", "x01000", "t91000", "t0abc", "t1UPPER", "an address", "t3vvmm62lofvhjd2ugzca6sof2j2ubwok6cj4xxbfzz4yuxfkgobpihhd2thlanmsh3w2ptld2gqkn2jvlss4a"}
+	for _, addr := range invalid {
+		fd, ok := m.checkDealConfig(DealConfig{Miner: addr})
+		if ok || fd.Reason != FailureInvalidAddress {
+			t.Errorf("expected %s to be invalid", addr)
+		}
+	}
+}
+
+func TestNewFailedDeal(t *testing.T) {
+	t.Parallel()
+	dconfig := DealConfig{Miner: "t01000"}
+	if fd := newFailedDeal(dconfig, context.DeadlineExceeded); fd.Reason != FailureTimeout {
+		t.Fatalf("expected timeout reason, got %s", fd.Reason)
+	}
+	fd := newFailedDeal(dconfig, errors.New("miner rejected"))
+	if fd.Reason != FailureRPCError || fd.Message != "miner rejected" || fd.Miner != "t01000" {
+		t.Fatalf("unexpected failed deal: %v", fd)
+	}
+}
+
+func TestFailedDealAskUnavailable(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t, ask.StorageAsk{Miner: "t01000"})
+	defer ai.Close()
+	m := &Module{askIndex: ai}
+	rpcErr := errors.New("miner rejected")
+	if fd := m.failedDeal(DealConfig{Miner: "t01000"}, rpcErr); fd.Reason != FailureRPCError {
+		t.Fatalf("expected rpc error reason, got %s", fd.Reason)
+	}
+	if fd := m.failedDeal(DealConfig{Miner: "t01001"}, rpcErr); fd.Reason != FailureAskUnavailable || fd.Message != "miner rejected" {
+		t.Fatalf("expected ask unavailable reason, got %v", fd)
+	}
+	if fd := m.failedDeal(DealConfig{Miner: "t01001"}, context.DeadlineExceeded); fd.Reason != FailureTimeout {
+		t.Fatalf("expected timeout reason, got %s", fd.Reason)
+	}
+
+	// miners without a storage ask are still proposed
+	if fd, ok := m.checkDealConfig(DealConfig{Miner: "t01001"}); !ok {
+		t.Fatalf("expected miner without ask to be proposed: %s", fd.Message)
+	}
+}
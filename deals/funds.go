@@ -0,0 +1,47 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// InsufficientFundsError returns when a wallet can't cover the cost of the
+// deals to be proposed
+type InsufficientFundsError struct {
+	Addr     string
+	Balance  types.BigInt
+	Required types.BigInt
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("insufficient funds in %s: balance %s, required %s", e.Addr, e.Balance, e.Required)
+}
+
+// checkFunds returns an InsufficientFundsError if addr balance can't cover the
+// deals with dealConfigs for size bytes and duration epochs.
+func (m *Module) checkFunds(ctx context.Context, addr string, size uint64, duration uint64, dealConfigs []DealConfig) error {
+	if m.wallet == nil {
+		return nil
+	}
+	required := requiredFunds(size, duration, dealConfigs)
+	balance, err := m.wallet.WalletBalance(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("error when getting wallet balance: %s", err)
+	}
+	if balance.LessThan(required) {
+		return &InsufficientFundsError{Addr: addr, Balance: balance, Required: required}
+	}
+	return nil
+}
+
+// requiredFunds returns the total cost of the deals with dealConfigs for size
+// bytes and duration epochs
+func requiredFunds(size uint64, duration uint64, dealConfigs []DealConfig) types.BigInt {
+	total := types.NewInt(0)
+	for _, dconfig := range dealConfigs {
+		total = types.BigAdd(total, dealCost(dconfig.EpochPrice, size, duration))
+	}
+	return total
+}
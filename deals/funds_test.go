@@ -0,0 +1,43 @@
+package deals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/wallet"
+)
+
+type mockWalletAPI struct {
+	wallet.API
+	balance types.BigInt
+}
+
+func (a *mockWalletAPI) WalletNew(ctx context.Context, typ string) (string, error) {
+	return "t3new", nil
+}
+
+func (a *mockWalletAPI) WalletBalance(ctx context.Context, addr string) (types.BigInt, error) {
+	return a.balance, nil
+}
+
+func TestCheckFunds(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	m := &Module{wallet: wallet.New(&mockWalletAPI{balance: types.NewInt(3000)})}
+	dealConfigs := []DealConfig{
+		{Miner: "t01", EpochPrice: types.NewInt(10)},
+		{Miner: "t02", EpochPrice: types.NewInt(20)},
+	}
+
+	checkErr(t, m.checkFunds(ctx, "t3addr", gib, 100, dealConfigs))
+
+	err := m.checkFunds(ctx, "t3addr", gib, 101, dealConfigs)
+	ife, ok := err.(*InsufficientFundsError)
+	if !ok {
+		t.Fatalf("expected InsufficientFundsError, got %v", err)
+	}
+	if !ife.Required.Equals(types.NewInt(3030)) || !ife.Balance.Equals(types.NewInt(3000)) {
+		t.Fatalf("unexpected error values: %v", ife)
+	}
+}
@@ -24,14 +24,114 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type SortOrder int32
+
+const (
+	SortOrder_PRICE     SortOrder = 0
+	SortOrder_POWER     SortOrder = 1
+	SortOrder_FRESHNESS SortOrder = 2
+)
+
+var SortOrder_name = map[int32]string{
+	0: "PRICE",
+	1: "POWER",
+	2: "FRESHNESS",
+}
+
+var SortOrder_value = map[string]int32{
+	"PRICE":     0,
+	"POWER":     1,
+	"FRESHNESS": 2,
+}
+
+func (x SortOrder) String() string {
+	return proto.EnumName(SortOrder_name, int32(x))
+}
+
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{0}
+}
+
+type AskChangeType int32
+
+const (
+	AskChangeType_NEW           AskChangeType = 0
+	AskChangeType_PRICE_CHANGED AskChangeType = 1
+	AskChangeType_EXPIRED       AskChangeType = 2
+	AskChangeType_REMOVED       AskChangeType = 3
+)
+
+var AskChangeType_name = map[int32]string{
+	0: "NEW",
+	1: "PRICE_CHANGED",
+	2: "EXPIRED",
+	3: "REMOVED",
+}
+
+var AskChangeType_value = map[string]int32{
+	"NEW":           0,
+	"PRICE_CHANGED": 1,
+	"EXPIRED":       2,
+	"REMOVED":       3,
+}
+
+func (x AskChangeType) String() string {
+	return proto.EnumName(AskChangeType_name, int32(x))
+}
+
+func (AskChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{1}
+}
+
+type FailureReason int32
+
+const (
+	FailureReason_UNKNOWN         FailureReason = 0
+	FailureReason_INVALID_ADDRESS FailureReason = 1
+	FailureReason_ASK_UNAVAILABLE FailureReason = 2
+	FailureReason_RPC_ERROR       FailureReason = 3
+	FailureReason_TIMEOUT         FailureReason = 4
+)
+
+var FailureReason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "INVALID_ADDRESS",
+	2: "ASK_UNAVAILABLE",
+	3: "RPC_ERROR",
+	4: "TIMEOUT",
+}
+
+var FailureReason_value = map[string]int32{
+	"UNKNOWN":         0,
+	"INVALID_ADDRESS": 1,
+	"ASK_UNAVAILABLE": 2,
+	"RPC_ERROR":       3,
+	"TIMEOUT":         4,
+}
+
+func (x FailureReason) String() string {
+	return proto.EnumName(FailureReason_name, int32(x))
+}
+
+func (FailureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{2}
+}
+
 type Query struct {
-	MaxPrice             uint64   `protobuf:"varint,1,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
-	PieceSize            uint64   `protobuf:"varint,2,opt,name=PieceSize,proto3" json:"PieceSize,omitempty"`
-	Limit                int32    `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
-	Offset               int32    `protobuf:"varint,4,opt,name=Offset,proto3" json:"Offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxPrice             uint64    `protobuf:"varint,1,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	PieceSize            uint64    `protobuf:"varint,2,opt,name=PieceSize,proto3" json:"PieceSize,omitempty"`
+	Limit                int32     `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset               int32     `protobuf:"varint,4,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	ExcludedMiners       []string  `protobuf:"bytes,5,rep,name=ExcludedMiners,proto3" json:"ExcludedMiners,omitempty"`
+	IncludedMiners       []string  `protobuf:"bytes,6,rep,name=IncludedMiners,proto3" json:"IncludedMiners,omitempty"`
+	NotExpired           bool      `protobuf:"varint,7,opt,name=NotExpired,proto3" json:"NotExpired,omitempty"`
+	MinPower             uint64    `protobuf:"varint,8,opt,name=MinPower,proto3" json:"MinPower,omitempty"`
+	Countries            []string  `protobuf:"bytes,9,rep,name=Countries,proto3" json:"Countries,omitempty"`
+	SortBy               SortOrder `protobuf:"varint,10,opt,name=SortBy,proto3,enum=filecoin.deals.pb.SortOrder" json:"SortBy,omitempty"`
+	OnlyVerified         bool      `protobuf:"varint,11,opt,name=OnlyVerified,proto3" json:"OnlyVerified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -87,12 +187,62 @@ func (m *Query) GetOffset() int32 {
 	return 0
 }
 
+func (m *Query) GetExcludedMiners() []string {
+	if m != nil {
+		return m.ExcludedMiners
+	}
+	return nil
+}
+
+func (m *Query) GetIncludedMiners() []string {
+	if m != nil {
+		return m.IncludedMiners
+	}
+	return nil
+}
+
+func (m *Query) GetNotExpired() bool {
+	if m != nil {
+		return m.NotExpired
+	}
+	return false
+}
+
+func (m *Query) GetMinPower() uint64 {
+	if m != nil {
+		return m.MinPower
+	}
+	return 0
+}
+
+func (m *Query) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *Query) GetSortBy() SortOrder {
+	if m != nil {
+		return m.SortBy
+	}
+	return SortOrder_PRICE
+}
+
+func (m *Query) GetOnlyVerified() bool {
+	if m != nil {
+		return m.OnlyVerified
+	}
+	return false
+}
+
 type StorageAsk struct {
 	Price                uint64   `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
 	MinPieceSize         uint64   `protobuf:"varint,2,opt,name=minPieceSize,proto3" json:"minPieceSize,omitempty"`
 	Miner                string   `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
 	Timestamp            uint64   `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Expiry               uint64   `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Verified             bool     `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -158,9 +308,16 @@ func (m *StorageAsk) GetExpiry() uint64 {
 	return 0
 }
 
+func (m *StorageAsk) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 type DealConfig struct {
 	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
-	EpochPrice           uint64   `protobuf:"varint,2,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	EpochPrice           string   `protobuf:"bytes,3,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -198,11 +355,11 @@ func (m *DealConfig) GetMiner() string {
 	return ""
 }
 
-func (m *DealConfig) GetEpochPrice() uint64 {
+func (m *DealConfig) GetEpochPrice() string {
 	if m != nil {
 		return m.EpochPrice
 	}
-	return 0
+	return ""
 }
 
 type DealInfo struct {
@@ -212,8 +369,10 @@ type DealInfo struct {
 	Miner                string   `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
 	PieceRef             []byte   `protobuf:"bytes,5,opt,name=pieceRef,proto3" json:"pieceRef,omitempty"`
 	Size                 uint64   `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
-	PricePerEpoch        uint64   `protobuf:"varint,7,opt,name=pricePerEpoch,proto3" json:"pricePerEpoch,omitempty"`
+	PricePerEpoch        string   `protobuf:"bytes,11,opt,name=pricePerEpoch,proto3" json:"pricePerEpoch,omitempty"`
 	Duration             uint64   `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	RenewedBy            string   `protobuf:"bytes,10,opt,name=renewedBy,proto3" json:"renewedBy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -286,11 +445,11 @@ func (m *DealInfo) GetSize() uint64 {
 	return 0
 }
 
-func (m *DealInfo) GetPricePerEpoch() uint64 {
+func (m *DealInfo) GetPricePerEpoch() string {
 	if m != nil {
 		return m.PricePerEpoch
 	}
-	return 0
+	return ""
 }
 
 func (m *DealInfo) GetDuration() uint64 {
@@ -300,6 +459,218 @@ func (m *DealInfo) GetDuration() uint64 {
 	return 0
 }
 
+func (m *DealInfo) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *DealInfo) GetRenewedBy() string {
+	if m != nil {
+		return m.RenewedBy
+	}
+	return ""
+}
+
+type DealRecord struct {
+	ProposalCid          string            `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	DataCid              string            `protobuf:"bytes,2,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
+	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Miner                string            `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	EpochPrice           string            `protobuf:"bytes,15,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	Duration             uint64            `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	CreatedAt            int64             `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Info                 *DealInfo         `protobuf:"bytes,8,opt,name=info,proto3" json:"info,omitempty"`
+	ActivationEpoch      uint64            `protobuf:"varint,9,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
+	RenewalOf            string            `protobuf:"bytes,10,opt,name=renewalOf,proto3" json:"renewalOf,omitempty"`
+	RenewedBy            string            `protobuf:"bytes,11,opt,name=renewedBy,proto3" json:"renewedBy,omitempty"`
+	ManifestCid          string            `protobuf:"bytes,12,opt,name=manifestCid,proto3" json:"manifestCid,omitempty"`
+	Encryption           *EncryptionParams `protobuf:"bytes,13,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	BatchCid             string            `protobuf:"bytes,14,opt,name=batchCid,proto3" json:"batchCid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DealRecord) Reset()         { *m = DealRecord{} }
+func (m *DealRecord) String() string { return proto.CompactTextString(m) }
+func (*DealRecord) ProtoMessage()    {}
+func (*DealRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{4}
+}
+
+func (m *DealRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DealRecord.Unmarshal(m, b)
+}
+func (m *DealRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DealRecord.Marshal(b, m, deterministic)
+}
+func (m *DealRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealRecord.Merge(m, src)
+}
+func (m *DealRecord) XXX_Size() int {
+	return xxx_messageInfo_DealRecord.Size(m)
+}
+func (m *DealRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealRecord proto.InternalMessageInfo
+
+func (m *DealRecord) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetDataCid() string {
+	if m != nil {
+		return m.DataCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DealRecord) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *DealRecord) GetEpochPrice() string {
+	if m != nil {
+		return m.EpochPrice
+	}
+	return ""
+}
+
+func (m *DealRecord) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *DealRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DealRecord) GetInfo() *DealInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *DealRecord) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *DealRecord) GetRenewalOf() string {
+	if m != nil {
+		return m.RenewalOf
+	}
+	return ""
+}
+
+func (m *DealRecord) GetRenewedBy() string {
+	if m != nil {
+		return m.RenewedBy
+	}
+	return ""
+}
+
+func (m *DealRecord) GetManifestCid() string {
+	if m != nil {
+		return m.ManifestCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetEncryption() *EncryptionParams {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+func (m *DealRecord) GetBatchCid() string {
+	if m != nil {
+		return m.BatchCid
+	}
+	return ""
+}
+
+type EncryptionParams struct {
+	Algorithm            string   `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Iv                   []byte   `protobuf:"bytes,2,opt,name=iv,proto3" json:"iv,omitempty"`
+	WrappedKey           []byte   `protobuf:"bytes,3,opt,name=wrappedKey,proto3" json:"wrappedKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncryptionParams) Reset()         { *m = EncryptionParams{} }
+func (m *EncryptionParams) String() string { return proto.CompactTextString(m) }
+func (*EncryptionParams) ProtoMessage()    {}
+func (*EncryptionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{5}
+}
+
+func (m *EncryptionParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptionParams.Unmarshal(m, b)
+}
+func (m *EncryptionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptionParams.Marshal(b, m, deterministic)
+}
+func (m *EncryptionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionParams.Merge(m, src)
+}
+func (m *EncryptionParams) XXX_Size() int {
+	return xxx_messageInfo_EncryptionParams.Size(m)
+}
+func (m *EncryptionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionParams proto.InternalMessageInfo
+
+func (m *EncryptionParams) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *EncryptionParams) GetIv() []byte {
+	if m != nil {
+		return m.Iv
+	}
+	return nil
+}
+
+func (m *EncryptionParams) GetWrappedKey() []byte {
+	if m != nil {
+		return m.WrappedKey
+	}
+	return nil
+}
+
 type AvailableAsksRequest struct {
 	Query                *Query   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -311,7 +682,7 @@ func (m *AvailableAsksRequest) Reset()         { *m = AvailableAsksRequest{} }
 func (m *AvailableAsksRequest) String() string { return proto.CompactTextString(m) }
 func (*AvailableAsksRequest) ProtoMessage()    {}
 func (*AvailableAsksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{4}
+	return fileDescriptor_71783c876a92172d, []int{6}
 }
 
 func (m *AvailableAsksRequest) XXX_Unmarshal(b []byte) error {
@@ -350,7 +721,7 @@ func (m *AvailableAsksReply) Reset()         { *m = AvailableAsksReply{} }
 func (m *AvailableAsksReply) String() string { return proto.CompactTextString(m) }
 func (*AvailableAsksReply) ProtoMessage()    {}
 func (*AvailableAsksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{5}
+	return fileDescriptor_71783c876a92172d, []int{7}
 }
 
 func (m *AvailableAsksReply) XXX_Unmarshal(b []byte) error {
@@ -378,515 +749,4336 @@ func (m *AvailableAsksReply) GetAsks() []*StorageAsk {
 	return nil
 }
 
-type StoreParams struct {
-	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	DealConfigs          []*DealConfig `protobuf:"bytes,2,rep,name=dealConfigs,proto3" json:"dealConfigs,omitempty"`
-	Duration             uint64        `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+type WatchAsksRequest struct {
+	Miners               []string `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchAsksRequest) Reset()         { *m = WatchAsksRequest{} }
+func (m *WatchAsksRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchAsksRequest) ProtoMessage()    {}
+func (*WatchAsksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{8}
+}
+
+func (m *WatchAsksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAsksRequest.Unmarshal(m, b)
+}
+func (m *WatchAsksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAsksRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchAsksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAsksRequest.Merge(m, src)
+}
+func (m *WatchAsksRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchAsksRequest.Size(m)
+}
+func (m *WatchAsksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAsksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAsksRequest proto.InternalMessageInfo
+
+func (m *WatchAsksRequest) GetMiners() []string {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+type AskChange struct {
+	Type                 AskChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=filecoin.deals.pb.AskChangeType" json:"type,omitempty"`
+	Ask                  *StorageAsk   `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	OldPrice             uint64        `protobuf:"varint,3,opt,name=oldPrice,proto3" json:"oldPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *StoreParams) Reset()         { *m = StoreParams{} }
-func (m *StoreParams) String() string { return proto.CompactTextString(m) }
-func (*StoreParams) ProtoMessage()    {}
-func (*StoreParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{6}
+func (m *AskChange) Reset()         { *m = AskChange{} }
+func (m *AskChange) String() string { return proto.CompactTextString(m) }
+func (*AskChange) ProtoMessage()    {}
+func (*AskChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{9}
 }
 
-func (m *StoreParams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StoreParams.Unmarshal(m, b)
+func (m *AskChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AskChange.Unmarshal(m, b)
 }
-func (m *StoreParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StoreParams.Marshal(b, m, deterministic)
+func (m *AskChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AskChange.Marshal(b, m, deterministic)
 }
-func (m *StoreParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StoreParams.Merge(m, src)
+func (m *AskChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AskChange.Merge(m, src)
 }
-func (m *StoreParams) XXX_Size() int {
-	return xxx_messageInfo_StoreParams.Size(m)
+func (m *AskChange) XXX_Size() int {
+	return xxx_messageInfo_AskChange.Size(m)
 }
-func (m *StoreParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_StoreParams.DiscardUnknown(m)
+func (m *AskChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AskChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StoreParams proto.InternalMessageInfo
+var xxx_messageInfo_AskChange proto.InternalMessageInfo
 
-func (m *StoreParams) GetAddress() string {
+func (m *AskChange) GetType() AskChangeType {
 	if m != nil {
-		return m.Address
+		return m.Type
 	}
-	return ""
+	return AskChangeType_NEW
 }
 
-func (m *StoreParams) GetDealConfigs() []*DealConfig {
+func (m *AskChange) GetAsk() *StorageAsk {
 	if m != nil {
-		return m.DealConfigs
+		return m.Ask
 	}
 	return nil
 }
 
-func (m *StoreParams) GetDuration() uint64 {
+func (m *AskChange) GetOldPrice() uint64 {
 	if m != nil {
-		return m.Duration
+		return m.OldPrice
 	}
 	return 0
 }
 
-type StoreRequest struct {
-	// Types that are valid to be assigned to Payload:
-	//	*StoreRequest_StoreParams
-	//	*StoreRequest_Chunk
-	Payload              isStoreRequest_Payload `protobuf_oneof:"payload"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type AskHistoryRequest struct {
+	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StoreRequest) Reset()         { *m = StoreRequest{} }
-func (m *StoreRequest) String() string { return proto.CompactTextString(m) }
-func (*StoreRequest) ProtoMessage()    {}
-func (*StoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{7}
+func (m *AskHistoryRequest) Reset()         { *m = AskHistoryRequest{} }
+func (m *AskHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AskHistoryRequest) ProtoMessage()    {}
+func (*AskHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{10}
 }
 
-func (m *StoreRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StoreRequest.Unmarshal(m, b)
+func (m *AskHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AskHistoryRequest.Unmarshal(m, b)
 }
-func (m *StoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StoreRequest.Marshal(b, m, deterministic)
+func (m *AskHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AskHistoryRequest.Marshal(b, m, deterministic)
 }
-func (m *StoreRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StoreRequest.Merge(m, src)
+func (m *AskHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AskHistoryRequest.Merge(m, src)
 }
-func (m *StoreRequest) XXX_Size() int {
-	return xxx_messageInfo_StoreRequest.Size(m)
+func (m *AskHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AskHistoryRequest.Size(m)
 }
-func (m *StoreRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StoreRequest.DiscardUnknown(m)
+func (m *AskHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AskHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StoreRequest proto.InternalMessageInfo
+var xxx_messageInfo_AskHistoryRequest proto.InternalMessageInfo
 
-type isStoreRequest_Payload interface {
-	isStoreRequest_Payload()
+func (m *AskHistoryRequest) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
 }
 
-type StoreRequest_StoreParams struct {
-	StoreParams *StoreParams `protobuf:"bytes,1,opt,name=storeParams,proto3,oneof"`
+func (m *AskHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
 }
 
-type StoreRequest_Chunk struct {
-	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+func (m *AskHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
 }
 
-func (*StoreRequest_StoreParams) isStoreRequest_Payload() {}
+type PricePoint struct {
+	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Price                uint64   `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-func (*StoreRequest_Chunk) isStoreRequest_Payload() {}
+func (m *PricePoint) Reset()         { *m = PricePoint{} }
+func (m *PricePoint) String() string { return proto.CompactTextString(m) }
+func (*PricePoint) ProtoMessage()    {}
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{11}
+}
 
-func (m *StoreRequest) GetPayload() isStoreRequest_Payload {
+func (m *PricePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PricePoint.Unmarshal(m, b)
+}
+func (m *PricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PricePoint.Marshal(b, m, deterministic)
+}
+func (m *PricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePoint.Merge(m, src)
+}
+func (m *PricePoint) XXX_Size() int {
+	return xxx_messageInfo_PricePoint.Size(m)
+}
+func (m *PricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePoint proto.InternalMessageInfo
+
+func (m *PricePoint) GetMiner() string {
 	if m != nil {
-		return m.Payload
+		return m.Miner
 	}
-	return nil
+	return ""
 }
 
-func (m *StoreRequest) GetStoreParams() *StoreParams {
-	if x, ok := m.GetPayload().(*StoreRequest_StoreParams); ok {
-		return x.StoreParams
+func (m *PricePoint) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
 	}
-	return nil
+	return 0
 }
 
-func (m *StoreRequest) GetChunk() []byte {
-	if x, ok := m.GetPayload().(*StoreRequest_Chunk); ok {
-		return x.Chunk
+func (m *PricePoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StoreRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StoreRequest_StoreParams)(nil),
-		(*StoreRequest_Chunk)(nil),
+type DailyPrice struct {
+	Day                  int64    `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Median               uint64   `protobuf:"varint,2,opt,name=median,proto3" json:"median,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DailyPrice) Reset()         { *m = DailyPrice{} }
+func (m *DailyPrice) String() string { return proto.CompactTextString(m) }
+func (*DailyPrice) ProtoMessage()    {}
+func (*DailyPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{12}
+}
+
+func (m *DailyPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DailyPrice.Unmarshal(m, b)
+}
+func (m *DailyPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DailyPrice.Marshal(b, m, deterministic)
+}
+func (m *DailyPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyPrice.Merge(m, src)
+}
+func (m *DailyPrice) XXX_Size() int {
+	return xxx_messageInfo_DailyPrice.Size(m)
+}
+func (m *DailyPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyPrice proto.InternalMessageInfo
+
+func (m *DailyPrice) GetDay() int64 {
+	if m != nil {
+		return m.Day
 	}
+	return 0
 }
 
-type StoreReply struct {
-	Cids                 []string      `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
-	FailedDeals          []*DealConfig `protobuf:"bytes,2,rep,name=failedDeals,proto3" json:"failedDeals,omitempty"`
+func (m *DailyPrice) GetMedian() uint64 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+type AskHistoryReply struct {
+	Points               []*PricePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	DailyMedians         []*DailyPrice `protobuf:"bytes,2,rep,name=dailyMedians,proto3" json:"dailyMedians,omitempty"`
+	Volatility           float64       `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *StoreReply) Reset()         { *m = StoreReply{} }
-func (m *StoreReply) String() string { return proto.CompactTextString(m) }
-func (*StoreReply) ProtoMessage()    {}
-func (*StoreReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{8}
+func (m *AskHistoryReply) Reset()         { *m = AskHistoryReply{} }
+func (m *AskHistoryReply) String() string { return proto.CompactTextString(m) }
+func (*AskHistoryReply) ProtoMessage()    {}
+func (*AskHistoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{13}
 }
 
-func (m *StoreReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StoreReply.Unmarshal(m, b)
+func (m *AskHistoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AskHistoryReply.Unmarshal(m, b)
 }
-func (m *StoreReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StoreReply.Marshal(b, m, deterministic)
+func (m *AskHistoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AskHistoryReply.Marshal(b, m, deterministic)
 }
-func (m *StoreReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StoreReply.Merge(m, src)
+func (m *AskHistoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AskHistoryReply.Merge(m, src)
 }
-func (m *StoreReply) XXX_Size() int {
-	return xxx_messageInfo_StoreReply.Size(m)
+func (m *AskHistoryReply) XXX_Size() int {
+	return xxx_messageInfo_AskHistoryReply.Size(m)
 }
-func (m *StoreReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_StoreReply.DiscardUnknown(m)
+func (m *AskHistoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AskHistoryReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StoreReply proto.InternalMessageInfo
+var xxx_messageInfo_AskHistoryReply proto.InternalMessageInfo
 
-func (m *StoreReply) GetCids() []string {
+func (m *AskHistoryReply) GetPoints() []*PricePoint {
 	if m != nil {
-		return m.Cids
+		return m.Points
 	}
 	return nil
 }
 
-func (m *StoreReply) GetFailedDeals() []*DealConfig {
+func (m *AskHistoryReply) GetDailyMedians() []*DailyPrice {
 	if m != nil {
-		return m.FailedDeals
+		return m.DailyMedians
 	}
 	return nil
 }
 
-type WatchRequest struct {
-	Proposals            []string `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+func (m *AskHistoryReply) GetVolatility() float64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	MaxAttempts          int32    `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	Backoff              int64    `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	PriceBump            uint64   `protobuf:"varint,3,opt,name=priceBump,proto3" json:"priceBump,omitempty"`
+	ReplicationFactor    int32    `protobuf:"varint,4,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
-func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchRequest) ProtoMessage()    {}
-func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{9}
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{14}
 }
 
-func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryPolicy.Unmarshal(m, b)
 }
-func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
 }
-func (m *WatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchRequest.Merge(m, src)
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
 }
-func (m *WatchRequest) XXX_Size() int {
-	return xxx_messageInfo_WatchRequest.Size(m)
+func (m *RetryPolicy) XXX_Size() int {
+	return xxx_messageInfo_RetryPolicy.Size(m)
 }
-func (m *WatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
 
-func (m *WatchRequest) GetProposals() []string {
+func (m *RetryPolicy) GetMaxAttempts() int32 {
 	if m != nil {
-		return m.Proposals
+		return m.MaxAttempts
 	}
-	return nil
+	return 0
 }
 
-type WatchReply struct {
-	DealInfo             *DealInfo `protobuf:"bytes,1,opt,name=dealInfo,proto3" json:"dealInfo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *RetryPolicy) GetBackoff() int64 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
 }
 
-func (m *WatchReply) Reset()         { *m = WatchReply{} }
-func (m *WatchReply) String() string { return proto.CompactTextString(m) }
-func (*WatchReply) ProtoMessage()    {}
-func (*WatchReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{10}
+func (m *RetryPolicy) GetPriceBump() uint64 {
+	if m != nil {
+		return m.PriceBump
+	}
+	return 0
 }
 
-func (m *WatchReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchReply.Unmarshal(m, b)
+func (m *RetryPolicy) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
 }
-func (m *WatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchReply.Marshal(b, m, deterministic)
+
+type RenewalPolicy struct {
+	Threshold            uint64   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MaxPrice             uint64   `protobuf:"varint,2,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *WatchReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchReply.Merge(m, src)
+
+func (m *RenewalPolicy) Reset()         { *m = RenewalPolicy{} }
+func (m *RenewalPolicy) String() string { return proto.CompactTextString(m) }
+func (*RenewalPolicy) ProtoMessage()    {}
+func (*RenewalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{15}
 }
-func (m *WatchReply) XXX_Size() int {
-	return xxx_messageInfo_WatchReply.Size(m)
+
+func (m *RenewalPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewalPolicy.Unmarshal(m, b)
 }
-func (m *WatchReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchReply.DiscardUnknown(m)
+func (m *RenewalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewalPolicy.Marshal(b, m, deterministic)
+}
+func (m *RenewalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewalPolicy.Merge(m, src)
+}
+func (m *RenewalPolicy) XXX_Size() int {
+	return xxx_messageInfo_RenewalPolicy.Size(m)
+}
+func (m *RenewalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewalPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchReply proto.InternalMessageInfo
+var xxx_messageInfo_RenewalPolicy proto.InternalMessageInfo
 
-func (m *WatchReply) GetDealInfo() *DealInfo {
+func (m *RenewalPolicy) GetThreshold() uint64 {
 	if m != nil {
-		return m.DealInfo
+		return m.Threshold
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Query)(nil), "filecoin.deals.pb.Query")
-	proto.RegisterType((*StorageAsk)(nil), "filecoin.deals.pb.StorageAsk")
-	proto.RegisterType((*DealConfig)(nil), "filecoin.deals.pb.DealConfig")
-	proto.RegisterType((*DealInfo)(nil), "filecoin.deals.pb.DealInfo")
-	proto.RegisterType((*AvailableAsksRequest)(nil), "filecoin.deals.pb.AvailableAsksRequest")
-	proto.RegisterType((*AvailableAsksReply)(nil), "filecoin.deals.pb.AvailableAsksReply")
-	proto.RegisterType((*StoreParams)(nil), "filecoin.deals.pb.StoreParams")
-	proto.RegisterType((*StoreRequest)(nil), "filecoin.deals.pb.StoreRequest")
-	proto.RegisterType((*StoreReply)(nil), "filecoin.deals.pb.StoreReply")
-	proto.RegisterType((*WatchRequest)(nil), "filecoin.deals.pb.WatchRequest")
-	proto.RegisterType((*WatchReply)(nil), "filecoin.deals.pb.WatchReply")
+func (m *RenewalPolicy) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("deals.proto", fileDescriptor_71783c876a92172d) }
+type Encryption struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_71783c876a92172d = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x4e, 0xdb, 0x4a,
-	0x10, 0x8e, 0x13, 0x1b, 0x92, 0x49, 0xb8, 0x38, 0x2b, 0x84, 0x2c, 0x0e, 0x70, 0x22, 0xeb, 0x1c,
-	0x9d, 0x5c, 0x54, 0x51, 0x4b, 0x55, 0xf5, 0xb2, 0x22, 0xfc, 0x14, 0xd4, 0xbf, 0x74, 0x41, 0xea,
-	0xf5, 0x62, 0x8f, 0x61, 0x85, 0xff, 0xf0, 0x6e, 0x2a, 0xd2, 0xcb, 0xaa, 0x4f, 0xd0, 0x47, 0xe8,
-	0xf3, 0xf5, 0x21, 0xaa, 0xfd, 0x31, 0x76, 0x20, 0x51, 0x7b, 0xe7, 0xf9, 0x76, 0xe6, 0x9b, 0xf9,
-	0xbe, 0x9d, 0x35, 0xf4, 0x23, 0x64, 0x89, 0x18, 0x17, 0x65, 0x2e, 0x73, 0xf2, 0x57, 0xcc, 0x13,
-	0x0c, 0x73, 0x9e, 0x8d, 0x2d, 0x7a, 0x19, 0xe4, 0xe0, 0x7d, 0x9c, 0x61, 0x39, 0x27, 0xdb, 0xd0,
-	0x7d, 0xc7, 0xee, 0xa6, 0x25, 0x0f, 0xd1, 0x77, 0x86, 0xce, 0xc8, 0xa5, 0xf7, 0x31, 0xd9, 0x81,
-	0xde, 0x94, 0x63, 0x88, 0xe7, 0xfc, 0x0b, 0xfa, 0x6d, 0x7d, 0x58, 0x03, 0x64, 0x13, 0xbc, 0xb7,
-	0x3c, 0xe5, 0xd2, 0xef, 0x0c, 0x9d, 0x91, 0x47, 0x4d, 0x40, 0xb6, 0x60, 0xed, 0x43, 0x1c, 0x0b,
-	0x94, 0xbe, 0xab, 0x61, 0x1b, 0x05, 0xdf, 0x1d, 0x80, 0x73, 0x99, 0x97, 0xec, 0x0a, 0x0f, 0xc4,
-	0x8d, 0x2a, 0x2e, 0x1a, 0x3d, 0x4d, 0x40, 0x02, 0x18, 0xa4, 0x3c, 0x7b, 0xd8, 0x73, 0x01, 0x53,
-	0x95, 0x29, 0xcf, 0xb0, 0xd4, 0x6d, 0x7b, 0xd4, 0x04, 0x6a, 0x54, 0xc9, 0x53, 0x14, 0x92, 0xa5,
-	0x85, 0xee, 0xec, 0xd2, 0x1a, 0x50, 0x43, 0xe1, 0x5d, 0xc1, 0xcb, 0xb9, 0xef, 0xe9, 0x23, 0x1b,
-	0x05, 0x13, 0x80, 0x23, 0x64, 0xc9, 0x61, 0x9e, 0xc5, 0xfc, 0xaa, 0x66, 0x76, 0x9a, 0xcc, 0x7b,
-	0x00, 0x58, 0xe4, 0xe1, 0xb5, 0xb1, 0xc8, 0x4c, 0xd4, 0x40, 0x82, 0x9f, 0x0e, 0x74, 0x15, 0xc9,
-	0x59, 0x16, 0xe7, 0x64, 0x08, 0xfd, 0xa2, 0xcc, 0x8b, 0x5c, 0xb0, 0xe4, 0x90, 0x47, 0x96, 0xa8,
-	0x09, 0x11, 0x1f, 0xd6, 0x85, 0x64, 0x12, 0xcf, 0x8e, 0x2c, 0x57, 0x15, 0x2a, 0x09, 0xfa, 0xf3,
-	0x3d, 0x4b, 0xd1, 0x8a, 0xab, 0x81, 0x7a, 0x38, 0xb7, 0x39, 0xdc, 0x36, 0x74, 0x0b, 0xe5, 0x0c,
-	0xc5, 0x58, 0x4b, 0x1b, 0xd0, 0xfb, 0x98, 0x10, 0x70, 0x85, 0x32, 0x71, 0x4d, 0xb7, 0xd1, 0xdf,
-	0xe4, 0x5f, 0xd8, 0xd0, 0x4e, 0x4f, 0xb1, 0x3c, 0x56, 0x12, 0xfc, 0x75, 0x7d, 0xb8, 0x08, 0x2a,
-	0xd6, 0x68, 0x56, 0x32, 0xc9, 0xf3, 0xcc, 0xef, 0x9a, 0x9d, 0xa8, 0xe2, 0xe0, 0x04, 0x36, 0x0f,
-	0x3e, 0x33, 0x9e, 0xb0, 0xcb, 0x44, 0x5d, 0xa4, 0xa0, 0x78, 0x3b, 0x43, 0x21, 0xc9, 0x18, 0xbc,
-	0x5b, 0xb5, 0x50, 0x5a, 0x73, 0x7f, 0xdf, 0x1f, 0x3f, 0xda, 0xb9, 0xb1, 0x5e, 0x38, 0x6a, 0xd2,
-	0x82, 0xd7, 0x40, 0x1e, 0xf0, 0x14, 0xc9, 0x9c, 0x3c, 0x03, 0x97, 0x89, 0x1b, 0xe1, 0x3b, 0xc3,
-	0xce, 0xa8, 0xbf, 0xbf, 0xbb, 0x84, 0xa4, 0xde, 0x21, 0xaa, 0x53, 0x83, 0x6f, 0x0e, 0xf4, 0x15,
-	0x88, 0x53, 0x56, 0xb2, 0x54, 0x28, 0x83, 0x59, 0x14, 0x95, 0x28, 0x84, 0xb5, 0xbf, 0x0a, 0xc9,
-	0x2b, 0xf3, 0x2a, 0xcc, 0x6d, 0x0b, 0xbf, 0xbd, 0xb2, 0x47, 0xbd, 0x13, 0xb4, 0x59, 0xb1, 0xe0,
-	0x4b, 0xe7, 0x81, 0x2f, 0x33, 0x18, 0xe8, 0x29, 0x2a, 0x3f, 0x26, 0xd0, 0x17, 0xf5, 0x54, 0xd6,
-	0x95, 0xbd, 0x15, 0x82, 0x6c, 0xd6, 0x69, 0x8b, 0x36, 0x8b, 0xc8, 0x16, 0x78, 0xe1, 0xf5, 0x2c,
-	0xbb, 0xd1, 0x9b, 0x32, 0x38, 0x6d, 0x51, 0x13, 0x4e, 0x7a, 0xb0, 0x5e, 0xb0, 0x79, 0x92, 0xb3,
-	0x28, 0x60, 0xe6, 0x55, 0xa1, 0xb1, 0x8f, 0x80, 0x1b, 0xf2, 0xc8, 0xd8, 0xd7, 0xa3, 0xfa, 0x5b,
-	0xa9, 0x8e, 0x19, 0x4f, 0x30, 0x52, 0xaa, 0xfe, 0x54, 0x75, 0xa3, 0x22, 0x78, 0x02, 0x83, 0x4f,
-	0x4c, 0x86, 0xd7, 0x95, 0xb2, 0x1d, 0xe8, 0x55, 0x0b, 0x5d, 0x75, 0xaa, 0x81, 0xe0, 0x18, 0xc0,
-	0x66, 0xab, 0x81, 0x5e, 0x42, 0x37, 0xb2, 0x6f, 0xc3, 0x5a, 0xf0, 0xf7, 0x8a, 0xce, 0x2a, 0x85,
-	0xde, 0x27, 0xef, 0x7f, 0x6d, 0x43, 0xe7, 0x60, 0x7a, 0x46, 0x18, 0x6c, 0x2c, 0xac, 0x09, 0xf9,
-	0x7f, 0x49, 0xfd, 0xb2, 0x85, 0xdc, 0xfe, 0xef, 0xf7, 0x89, 0x45, 0x32, 0x0f, 0x5a, 0xe4, 0x0d,
-	0x78, 0xda, 0x42, 0xf2, 0xcf, 0xaa, 0xdb, 0xa9, 0x28, 0x77, 0x57, 0x27, 0x68, 0xaa, 0x91, 0xa3,
-	0xc8, 0xb4, 0xfc, 0xa5, 0x64, 0x4d, 0x1b, 0x97, 0x92, 0xd5, 0xce, 0x05, 0xad, 0xa7, 0xce, 0xe4,
-	0x05, 0xec, 0xf0, 0x7c, 0x2c, 0xf1, 0x4e, 0xf2, 0x04, 0x1f, 0xa7, 0x4f, 0x36, 0x4e, 0x2c, 0xa4,
-	0x2f, 0x6a, 0xea, 0xfc, 0x68, 0x77, 0x2e, 0x2e, 0x8e, 0x2f, 0xd7, 0xf4, 0x5f, 0xff, 0xf9, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x4b, 0x3b, 0x82, 0x04, 0x06, 0x00, 0x00,
+func (m *Encryption) Reset()         { *m = Encryption{} }
+func (m *Encryption) String() string { return proto.CompactTextString(m) }
+func (*Encryption) ProtoMessage()    {}
+func (*Encryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{16}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *Encryption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Encryption.Unmarshal(m, b)
+}
+func (m *Encryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Encryption.Marshal(b, m, deterministic)
+}
+func (m *Encryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Encryption.Merge(m, src)
+}
+func (m *Encryption) XXX_Size() int {
+	return xxx_messageInfo_Encryption.Size(m)
+}
+func (m *Encryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Encryption.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_Encryption proto.InternalMessageInfo
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	AvailableAsks(ctx context.Context, in *AvailableAsksRequest, opts ...grpc.CallOption) (*AvailableAsksReply, error)
-	Store(ctx context.Context, opts ...grpc.CallOption) (API_StoreClient, error)
-	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error)
+func (m *Encryption) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Encryption) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type StoreParams struct {
+	Address              string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DealConfigs          []*DealConfig  `protobuf:"bytes,2,rep,name=dealConfigs,proto3" json:"dealConfigs,omitempty"`
+	Duration             uint64         `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	RetryPolicy          *RetryPolicy   `protobuf:"bytes,4,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	RenewalPolicy        *RenewalPolicy `protobuf:"bytes,5,opt,name=renewalPolicy,proto3" json:"renewalPolicy,omitempty"`
+	ChunkSize            uint64         `protobuf:"varint,6,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	Encryption           *Encryption    `protobuf:"bytes,7,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StoreParams) Reset()         { *m = StoreParams{} }
+func (m *StoreParams) String() string { return proto.CompactTextString(m) }
+func (*StoreParams) ProtoMessage()    {}
+func (*StoreParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{17}
+}
+
+func (m *StoreParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreParams.Unmarshal(m, b)
+}
+func (m *StoreParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreParams.Marshal(b, m, deterministic)
+}
+func (m *StoreParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreParams.Merge(m, src)
+}
+func (m *StoreParams) XXX_Size() int {
+	return xxx_messageInfo_StoreParams.Size(m)
+}
+func (m *StoreParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreParams proto.InternalMessageInfo
+
+func (m *StoreParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StoreParams) GetDealConfigs() []*DealConfig {
+	if m != nil {
+		return m.DealConfigs
+	}
+	return nil
+}
+
+func (m *StoreParams) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *StoreParams) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StoreParams) GetRenewalPolicy() *RenewalPolicy {
+	if m != nil {
+		return m.RenewalPolicy
+	}
+	return nil
+}
+
+func (m *StoreParams) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *StoreParams) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+type StoreRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*StoreRequest_StoreParams
+	//	*StoreRequest_Chunk
+	Payload              isStoreRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *StoreRequest) Reset()         { *m = StoreRequest{} }
+func (m *StoreRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreRequest) ProtoMessage()    {}
+func (*StoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{18}
+}
+
+func (m *StoreRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreRequest.Unmarshal(m, b)
+}
+func (m *StoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreRequest.Marshal(b, m, deterministic)
+}
+func (m *StoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreRequest.Merge(m, src)
+}
+func (m *StoreRequest) XXX_Size() int {
+	return xxx_messageInfo_StoreRequest.Size(m)
+}
+func (m *StoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreRequest proto.InternalMessageInfo
+
+type isStoreRequest_Payload interface {
+	isStoreRequest_Payload()
+}
+
+type StoreRequest_StoreParams struct {
+	StoreParams *StoreParams `protobuf:"bytes,1,opt,name=storeParams,proto3,oneof"`
+}
+
+type StoreRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StoreRequest_StoreParams) isStoreRequest_Payload() {}
+
+func (*StoreRequest_Chunk) isStoreRequest_Payload() {}
+
+func (m *StoreRequest) GetPayload() isStoreRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *StoreRequest) GetStoreParams() *StoreParams {
+	if x, ok := m.GetPayload().(*StoreRequest_StoreParams); ok {
+		return x.StoreParams
+	}
+	return nil
+}
+
+func (m *StoreRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*StoreRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StoreRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StoreRequest_StoreParams)(nil),
+		(*StoreRequest_Chunk)(nil),
+	}
+}
+
+type StoreAutoParams struct {
+	Address              string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ReplicationFactor    int32          `protobuf:"varint,2,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	MaxPrice             uint64         `protobuf:"varint,3,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	PieceSize            uint64         `protobuf:"varint,4,opt,name=pieceSize,proto3" json:"pieceSize,omitempty"`
+	Duration             uint64         `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	RetryPolicy          *RetryPolicy   `protobuf:"bytes,6,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	RenewalPolicy        *RenewalPolicy `protobuf:"bytes,7,opt,name=renewalPolicy,proto3" json:"renewalPolicy,omitempty"`
+	ChunkSize            uint64         `protobuf:"varint,8,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	Encryption           *Encryption    `protobuf:"bytes,9,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	ExcludedCountries    []string       `protobuf:"bytes,10,rep,name=excludedCountries,proto3" json:"excludedCountries,omitempty"`
+	MinReputation        int32          `protobuf:"varint,11,opt,name=minReputation,proto3" json:"minReputation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StoreAutoParams) Reset()         { *m = StoreAutoParams{} }
+func (m *StoreAutoParams) String() string { return proto.CompactTextString(m) }
+func (*StoreAutoParams) ProtoMessage()    {}
+func (*StoreAutoParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{19}
+}
+
+func (m *StoreAutoParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreAutoParams.Unmarshal(m, b)
+}
+func (m *StoreAutoParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreAutoParams.Marshal(b, m, deterministic)
+}
+func (m *StoreAutoParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreAutoParams.Merge(m, src)
+}
+func (m *StoreAutoParams) XXX_Size() int {
+	return xxx_messageInfo_StoreAutoParams.Size(m)
+}
+func (m *StoreAutoParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreAutoParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreAutoParams proto.InternalMessageInfo
+
+func (m *StoreAutoParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StoreAutoParams) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *StoreAutoParams) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *StoreAutoParams) GetPieceSize() uint64 {
+	if m != nil {
+		return m.PieceSize
+	}
+	return 0
+}
+
+func (m *StoreAutoParams) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *StoreAutoParams) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StoreAutoParams) GetRenewalPolicy() *RenewalPolicy {
+	if m != nil {
+		return m.RenewalPolicy
+	}
+	return nil
+}
+
+func (m *StoreAutoParams) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *StoreAutoParams) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+func (m *StoreAutoParams) GetExcludedCountries() []string {
+	if m != nil {
+		return m.ExcludedCountries
+	}
+	return nil
+}
+
+func (m *StoreAutoParams) GetMinReputation() int32 {
+	if m != nil {
+		return m.MinReputation
+	}
+	return 0
+}
+
+type StoreAutoRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*StoreAutoRequest_StoreAutoParams
+	//	*StoreAutoRequest_Chunk
+	Payload              isStoreAutoRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *StoreAutoRequest) Reset()         { *m = StoreAutoRequest{} }
+func (m *StoreAutoRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreAutoRequest) ProtoMessage()    {}
+func (*StoreAutoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{20}
+}
+
+func (m *StoreAutoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreAutoRequest.Unmarshal(m, b)
+}
+func (m *StoreAutoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreAutoRequest.Marshal(b, m, deterministic)
+}
+func (m *StoreAutoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreAutoRequest.Merge(m, src)
+}
+func (m *StoreAutoRequest) XXX_Size() int {
+	return xxx_messageInfo_StoreAutoRequest.Size(m)
+}
+func (m *StoreAutoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreAutoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreAutoRequest proto.InternalMessageInfo
+
+type isStoreAutoRequest_Payload interface {
+	isStoreAutoRequest_Payload()
+}
+
+type StoreAutoRequest_StoreAutoParams struct {
+	StoreAutoParams *StoreAutoParams `protobuf:"bytes,1,opt,name=storeAutoParams,proto3,oneof"`
+}
+
+type StoreAutoRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StoreAutoRequest_StoreAutoParams) isStoreAutoRequest_Payload() {}
+
+func (*StoreAutoRequest_Chunk) isStoreAutoRequest_Payload() {}
+
+func (m *StoreAutoRequest) GetPayload() isStoreAutoRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *StoreAutoRequest) GetStoreAutoParams() *StoreAutoParams {
+	if x, ok := m.GetPayload().(*StoreAutoRequest_StoreAutoParams); ok {
+		return x.StoreAutoParams
+	}
+	return nil
+}
+
+func (m *StoreAutoRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*StoreAutoRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StoreAutoRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StoreAutoRequest_StoreAutoParams)(nil),
+		(*StoreAutoRequest_Chunk)(nil),
+	}
+}
+
+type FailedDeal struct {
+	DealConfig           *DealConfig   `protobuf:"bytes,1,opt,name=dealConfig,proto3" json:"dealConfig,omitempty"`
+	Reason               FailureReason `protobuf:"varint,2,opt,name=reason,proto3,enum=filecoin.deals.pb.FailureReason" json:"reason,omitempty"`
+	Message              string        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FailedDeal) Reset()         { *m = FailedDeal{} }
+func (m *FailedDeal) String() string { return proto.CompactTextString(m) }
+func (*FailedDeal) ProtoMessage()    {}
+func (*FailedDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{21}
+}
+
+func (m *FailedDeal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedDeal.Unmarshal(m, b)
+}
+func (m *FailedDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedDeal.Marshal(b, m, deterministic)
+}
+func (m *FailedDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedDeal.Merge(m, src)
+}
+func (m *FailedDeal) XXX_Size() int {
+	return xxx_messageInfo_FailedDeal.Size(m)
+}
+func (m *FailedDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedDeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedDeal proto.InternalMessageInfo
+
+func (m *FailedDeal) GetDealConfig() *DealConfig {
+	if m != nil {
+		return m.DealConfig
+	}
+	return nil
+}
+
+func (m *FailedDeal) GetReason() FailureReason {
+	if m != nil {
+		return m.Reason
+	}
+	return FailureReason_UNKNOWN
+}
+
+func (m *FailedDeal) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type StoreReply struct {
+	Cids                 []string      `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	FailedDeals          []*FailedDeal `protobuf:"bytes,3,rep,name=failedDeals,proto3" json:"failedDeals,omitempty"`
+	EncryptionKey        []byte        `protobuf:"bytes,4,opt,name=encryptionKey,proto3" json:"encryptionKey,omitempty"`
+	WrappedKey           []byte        `protobuf:"bytes,5,opt,name=wrappedKey,proto3" json:"wrappedKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StoreReply) Reset()         { *m = StoreReply{} }
+func (m *StoreReply) String() string { return proto.CompactTextString(m) }
+func (*StoreReply) ProtoMessage()    {}
+func (*StoreReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{22}
+}
+
+func (m *StoreReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreReply.Unmarshal(m, b)
+}
+func (m *StoreReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreReply.Marshal(b, m, deterministic)
+}
+func (m *StoreReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReply.Merge(m, src)
+}
+func (m *StoreReply) XXX_Size() int {
+	return xxx_messageInfo_StoreReply.Size(m)
+}
+func (m *StoreReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreReply proto.InternalMessageInfo
+
+func (m *StoreReply) GetCids() []string {
+	if m != nil {
+		return m.Cids
+	}
+	return nil
+}
+
+func (m *StoreReply) GetFailedDeals() []*FailedDeal {
+	if m != nil {
+		return m.FailedDeals
+	}
+	return nil
+}
+
+func (m *StoreReply) GetEncryptionKey() []byte {
+	if m != nil {
+		return m.EncryptionKey
+	}
+	return nil
+}
+
+func (m *StoreReply) GetWrappedKey() []byte {
+	if m != nil {
+		return m.WrappedKey
+	}
+	return nil
+}
+
+type WatchRequest struct {
+	Proposals            []string `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Since                int64    `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Miners               []string `protobuf:"bytes,3,rep,name=miners,proto3" json:"miners,omitempty"`
+	States               []uint64 `protobuf:"varint,4,rep,packed,name=states,proto3" json:"states,omitempty"`
+	UntilDone            bool     `protobuf:"varint,5,opt,name=untilDone,proto3" json:"untilDone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{23}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetProposals() []string {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *WatchRequest) GetMiners() []string {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetStates() []uint64 {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetUntilDone() bool {
+	if m != nil {
+		return m.UntilDone
+	}
+	return false
+}
+
+type WatchSummary struct {
+	Deals                []*DealInfo `protobuf:"bytes,1,rep,name=deals,proto3" json:"deals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *WatchSummary) Reset()         { *m = WatchSummary{} }
+func (m *WatchSummary) String() string { return proto.CompactTextString(m) }
+func (*WatchSummary) ProtoMessage()    {}
+func (*WatchSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{24}
+}
+
+func (m *WatchSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchSummary.Unmarshal(m, b)
+}
+func (m *WatchSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchSummary.Marshal(b, m, deterministic)
+}
+func (m *WatchSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchSummary.Merge(m, src)
+}
+func (m *WatchSummary) XXX_Size() int {
+	return xxx_messageInfo_WatchSummary.Size(m)
+}
+func (m *WatchSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchSummary proto.InternalMessageInfo
+
+func (m *WatchSummary) GetDeals() []*DealInfo {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+type WatchReply struct {
+	DealInfo             *DealInfo     `protobuf:"bytes,1,opt,name=dealInfo,proto3" json:"dealInfo,omitempty"`
+	Summary              *WatchSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WatchReply) Reset()         { *m = WatchReply{} }
+func (m *WatchReply) String() string { return proto.CompactTextString(m) }
+func (*WatchReply) ProtoMessage()    {}
+func (*WatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{25}
+}
+
+func (m *WatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchReply.Unmarshal(m, b)
+}
+func (m *WatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchReply.Marshal(b, m, deterministic)
+}
+func (m *WatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchReply.Merge(m, src)
+}
+func (m *WatchReply) XXX_Size() int {
+	return xxx_messageInfo_WatchReply.Size(m)
+}
+func (m *WatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchReply proto.InternalMessageInfo
+
+func (m *WatchReply) GetDealInfo() *DealInfo {
+	if m != nil {
+		return m.DealInfo
+	}
+	return nil
+}
+
+func (m *WatchReply) GetSummary() *WatchSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+type RetrieveRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
+func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
+func (*RetrieveRequest) ProtoMessage()    {}
+func (*RetrieveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{26}
+}
+
+func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveRequest.Unmarshal(m, b)
+}
+func (m *RetrieveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveRequest.Marshal(b, m, deterministic)
+}
+func (m *RetrieveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveRequest.Merge(m, src)
+}
+func (m *RetrieveRequest) XXX_Size() int {
+	return xxx_messageInfo_RetrieveRequest.Size(m)
+}
+func (m *RetrieveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveRequest proto.InternalMessageInfo
+
+func (m *RetrieveRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RetrieveRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *RetrieveRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type RetrieveReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveReply) Reset()         { *m = RetrieveReply{} }
+func (m *RetrieveReply) String() string { return proto.CompactTextString(m) }
+func (*RetrieveReply) ProtoMessage()    {}
+func (*RetrieveReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{27}
+}
+
+func (m *RetrieveReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveReply.Unmarshal(m, b)
+}
+func (m *RetrieveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveReply.Marshal(b, m, deterministic)
+}
+func (m *RetrieveReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveReply.Merge(m, src)
+}
+func (m *RetrieveReply) XXX_Size() int {
+	return xxx_messageInfo_RetrieveReply.Size(m)
+}
+func (m *RetrieveReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveReply proto.InternalMessageInfo
+
+func (m *RetrieveReply) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type BatchFileHeader struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchFileHeader) Reset()         { *m = BatchFileHeader{} }
+func (m *BatchFileHeader) String() string { return proto.CompactTextString(m) }
+func (*BatchFileHeader) ProtoMessage()    {}
+func (*BatchFileHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{28}
+}
+
+func (m *BatchFileHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchFileHeader.Unmarshal(m, b)
+}
+func (m *BatchFileHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchFileHeader.Marshal(b, m, deterministic)
+}
+func (m *BatchFileHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFileHeader.Merge(m, src)
+}
+func (m *BatchFileHeader) XXX_Size() int {
+	return xxx_messageInfo_BatchFileHeader.Size(m)
+}
+func (m *BatchFileHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFileHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchFileHeader proto.InternalMessageInfo
+
+func (m *BatchFileHeader) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type StoreBatchRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*StoreBatchRequest_StoreParams
+	//	*StoreBatchRequest_File
+	//	*StoreBatchRequest_Chunk
+	Payload              isStoreBatchRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *StoreBatchRequest) Reset()         { *m = StoreBatchRequest{} }
+func (m *StoreBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreBatchRequest) ProtoMessage()    {}
+func (*StoreBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{29}
+}
+
+func (m *StoreBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreBatchRequest.Unmarshal(m, b)
+}
+func (m *StoreBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *StoreBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreBatchRequest.Merge(m, src)
+}
+func (m *StoreBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_StoreBatchRequest.Size(m)
+}
+func (m *StoreBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreBatchRequest proto.InternalMessageInfo
+
+type isStoreBatchRequest_Payload interface {
+	isStoreBatchRequest_Payload()
+}
+
+type StoreBatchRequest_StoreParams struct {
+	StoreParams *StoreParams `protobuf:"bytes,1,opt,name=storeParams,proto3,oneof"`
+}
+
+type StoreBatchRequest_File struct {
+	File *BatchFileHeader `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type StoreBatchRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StoreBatchRequest_StoreParams) isStoreBatchRequest_Payload() {}
+
+func (*StoreBatchRequest_File) isStoreBatchRequest_Payload() {}
+
+func (*StoreBatchRequest_Chunk) isStoreBatchRequest_Payload() {}
+
+func (m *StoreBatchRequest) GetPayload() isStoreBatchRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *StoreBatchRequest) GetStoreParams() *StoreParams {
+	if x, ok := m.GetPayload().(*StoreBatchRequest_StoreParams); ok {
+		return x.StoreParams
+	}
+	return nil
+}
+
+func (m *StoreBatchRequest) GetFile() *BatchFileHeader {
+	if x, ok := m.GetPayload().(*StoreBatchRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (m *StoreBatchRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*StoreBatchRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StoreBatchRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StoreBatchRequest_StoreParams)(nil),
+		(*StoreBatchRequest_File)(nil),
+		(*StoreBatchRequest_Chunk)(nil),
+	}
+}
+
+type BatchEntry struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size                 uint64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchEntry) Reset()         { *m = BatchEntry{} }
+func (m *BatchEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchEntry) ProtoMessage()    {}
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{30}
+}
+
+func (m *BatchEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchEntry.Unmarshal(m, b)
+}
+func (m *BatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchEntry.Marshal(b, m, deterministic)
+}
+func (m *BatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEntry.Merge(m, src)
+}
+func (m *BatchEntry) XXX_Size() int {
+	return xxx_messageInfo_BatchEntry.Size(m)
+}
+func (m *BatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchEntry proto.InternalMessageInfo
+
+func (m *BatchEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BatchEntry) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BatchEntry) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type Batch struct {
+	Cid                  string        `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	DataCid              string        `protobuf:"bytes,2,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
+	Size                 uint64        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Files                []*BatchEntry `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	CreatedAt            int64         `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Batch) Reset()         { *m = Batch{} }
+func (m *Batch) String() string { return proto.CompactTextString(m) }
+func (*Batch) ProtoMessage()    {}
+func (*Batch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{31}
+}
+
+func (m *Batch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Batch.Unmarshal(m, b)
+}
+func (m *Batch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Batch.Marshal(b, m, deterministic)
+}
+func (m *Batch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Batch.Merge(m, src)
+}
+func (m *Batch) XXX_Size() int {
+	return xxx_messageInfo_Batch.Size(m)
+}
+func (m *Batch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Batch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Batch proto.InternalMessageInfo
+
+func (m *Batch) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *Batch) GetDataCid() string {
+	if m != nil {
+		return m.DataCid
+	}
+	return ""
+}
+
+func (m *Batch) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Batch) GetFiles() []*BatchEntry {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *Batch) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type StoreBatchReply struct {
+	Batch                *Batch      `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	StoreReply           *StoreReply `protobuf:"bytes,2,opt,name=storeReply,proto3" json:"storeReply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StoreBatchReply) Reset()         { *m = StoreBatchReply{} }
+func (m *StoreBatchReply) String() string { return proto.CompactTextString(m) }
+func (*StoreBatchReply) ProtoMessage()    {}
+func (*StoreBatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{32}
+}
+
+func (m *StoreBatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreBatchReply.Unmarshal(m, b)
+}
+func (m *StoreBatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreBatchReply.Marshal(b, m, deterministic)
+}
+func (m *StoreBatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreBatchReply.Merge(m, src)
+}
+func (m *StoreBatchReply) XXX_Size() int {
+	return xxx_messageInfo_StoreBatchReply.Size(m)
+}
+func (m *StoreBatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreBatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreBatchReply proto.InternalMessageInfo
+
+func (m *StoreBatchReply) GetBatch() *Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *StoreBatchReply) GetStoreReply() *StoreReply {
+	if m != nil {
+		return m.StoreReply
+	}
+	return nil
+}
+
+type GetBatchRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBatchRequest) Reset()         { *m = GetBatchRequest{} }
+func (m *GetBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBatchRequest) ProtoMessage()    {}
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{33}
+}
+
+func (m *GetBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchRequest.Unmarshal(m, b)
+}
+func (m *GetBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchRequest.Merge(m, src)
+}
+func (m *GetBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBatchRequest.Size(m)
+}
+func (m *GetBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBatchRequest proto.InternalMessageInfo
+
+func (m *GetBatchRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type GetBatchReply struct {
+	Batch                *Batch   `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBatchReply) Reset()         { *m = GetBatchReply{} }
+func (m *GetBatchReply) String() string { return proto.CompactTextString(m) }
+func (*GetBatchReply) ProtoMessage()    {}
+func (*GetBatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{34}
+}
+
+func (m *GetBatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBatchReply.Unmarshal(m, b)
+}
+func (m *GetBatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBatchReply.Marshal(b, m, deterministic)
+}
+func (m *GetBatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBatchReply.Merge(m, src)
+}
+func (m *GetBatchReply) XXX_Size() int {
+	return xxx_messageInfo_GetBatchReply.Size(m)
+}
+func (m *GetBatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBatchReply proto.InternalMessageInfo
+
+func (m *GetBatchReply) GetBatch() *Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+type RetrieveFileRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BatchCid             string   `protobuf:"bytes,2,opt,name=batchCid,proto3" json:"batchCid,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveFileRequest) Reset()         { *m = RetrieveFileRequest{} }
+func (m *RetrieveFileRequest) String() string { return proto.CompactTextString(m) }
+func (*RetrieveFileRequest) ProtoMessage()    {}
+func (*RetrieveFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{35}
+}
+
+func (m *RetrieveFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveFileRequest.Unmarshal(m, b)
+}
+func (m *RetrieveFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveFileRequest.Marshal(b, m, deterministic)
+}
+func (m *RetrieveFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveFileRequest.Merge(m, src)
+}
+func (m *RetrieveFileRequest) XXX_Size() int {
+	return xxx_messageInfo_RetrieveFileRequest.Size(m)
+}
+func (m *RetrieveFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveFileRequest proto.InternalMessageInfo
+
+func (m *RetrieveFileRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RetrieveFileRequest) GetBatchCid() string {
+	if m != nil {
+		return m.BatchCid
+	}
+	return ""
+}
+
+func (m *RetrieveFileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RetrieveFileRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type EstimateRequest struct {
+	Size                 uint64        `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Duration             uint64        `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	DealConfigs          []*DealConfig `protobuf:"bytes,3,rep,name=dealConfigs,proto3" json:"dealConfigs,omitempty"`
+	ReplicationFactor    int32         `protobuf:"varint,4,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	MaxPrice             uint64        `protobuf:"varint,5,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EstimateRequest) Reset()         { *m = EstimateRequest{} }
+func (m *EstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateRequest) ProtoMessage()    {}
+func (*EstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{36}
+}
+
+func (m *EstimateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateRequest.Unmarshal(m, b)
+}
+func (m *EstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateRequest.Merge(m, src)
+}
+func (m *EstimateRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateRequest.Size(m)
+}
+func (m *EstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateRequest proto.InternalMessageInfo
+
+func (m *EstimateRequest) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *EstimateRequest) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *EstimateRequest) GetDealConfigs() []*DealConfig {
+	if m != nil {
+		return m.DealConfigs
+	}
+	return nil
+}
+
+func (m *EstimateRequest) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *EstimateRequest) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+type DealEstimation struct {
+	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	EpochPrice           string   `protobuf:"bytes,5,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	Cost                 string   `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	Warnings             []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DealEstimation) Reset()         { *m = DealEstimation{} }
+func (m *DealEstimation) String() string { return proto.CompactTextString(m) }
+func (*DealEstimation) ProtoMessage()    {}
+func (*DealEstimation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{37}
+}
+
+func (m *DealEstimation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DealEstimation.Unmarshal(m, b)
+}
+func (m *DealEstimation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DealEstimation.Marshal(b, m, deterministic)
+}
+func (m *DealEstimation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealEstimation.Merge(m, src)
+}
+func (m *DealEstimation) XXX_Size() int {
+	return xxx_messageInfo_DealEstimation.Size(m)
+}
+func (m *DealEstimation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealEstimation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealEstimation proto.InternalMessageInfo
+
+func (m *DealEstimation) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *DealEstimation) GetEpochPrice() string {
+	if m != nil {
+		return m.EpochPrice
+	}
+	return ""
+}
+
+func (m *DealEstimation) GetCost() string {
+	if m != nil {
+		return m.Cost
+	}
+	return ""
+}
+
+func (m *DealEstimation) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type EstimateReply struct {
+	Total                string            `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Deals                []*DealEstimation `protobuf:"bytes,2,rep,name=deals,proto3" json:"deals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EstimateReply) Reset()         { *m = EstimateReply{} }
+func (m *EstimateReply) String() string { return proto.CompactTextString(m) }
+func (*EstimateReply) ProtoMessage()    {}
+func (*EstimateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{38}
+}
+
+func (m *EstimateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateReply.Unmarshal(m, b)
+}
+func (m *EstimateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateReply.Marshal(b, m, deterministic)
+}
+func (m *EstimateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateReply.Merge(m, src)
+}
+func (m *EstimateReply) XXX_Size() int {
+	return xxx_messageInfo_EstimateReply.Size(m)
+}
+func (m *EstimateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateReply proto.InternalMessageInfo
+
+func (m *EstimateReply) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *EstimateReply) GetDeals() []*DealEstimation {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+type ListDealsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDealsRequest) Reset()         { *m = ListDealsRequest{} }
+func (m *ListDealsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDealsRequest) ProtoMessage()    {}
+func (*ListDealsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{39}
+}
+
+func (m *ListDealsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDealsRequest.Unmarshal(m, b)
+}
+func (m *ListDealsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDealsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDealsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDealsRequest.Merge(m, src)
+}
+func (m *ListDealsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDealsRequest.Size(m)
+}
+func (m *ListDealsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDealsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDealsRequest proto.InternalMessageInfo
+
+type ListDealsReply struct {
+	Records              []*DealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListDealsReply) Reset()         { *m = ListDealsReply{} }
+func (m *ListDealsReply) String() string { return proto.CompactTextString(m) }
+func (*ListDealsReply) ProtoMessage()    {}
+func (*ListDealsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{40}
+}
+
+func (m *ListDealsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDealsReply.Unmarshal(m, b)
+}
+func (m *ListDealsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDealsReply.Marshal(b, m, deterministic)
+}
+func (m *ListDealsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDealsReply.Merge(m, src)
+}
+func (m *ListDealsReply) XXX_Size() int {
+	return xxx_messageInfo_ListDealsReply.Size(m)
+}
+func (m *ListDealsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDealsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDealsReply proto.InternalMessageInfo
+
+func (m *ListDealsReply) GetRecords() []*DealRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type GetDealRequest struct {
+	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDealRequest) Reset()         { *m = GetDealRequest{} }
+func (m *GetDealRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDealRequest) ProtoMessage()    {}
+func (*GetDealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{41}
+}
+
+func (m *GetDealRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDealRequest.Unmarshal(m, b)
+}
+func (m *GetDealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDealRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDealRequest.Merge(m, src)
+}
+func (m *GetDealRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDealRequest.Size(m)
+}
+func (m *GetDealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDealRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDealRequest proto.InternalMessageInfo
+
+func (m *GetDealRequest) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+type GetDealReply struct {
+	Record               *DealRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetDealReply) Reset()         { *m = GetDealReply{} }
+func (m *GetDealReply) String() string { return proto.CompactTextString(m) }
+func (*GetDealReply) ProtoMessage()    {}
+func (*GetDealReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{42}
+}
+
+func (m *GetDealReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDealReply.Unmarshal(m, b)
+}
+func (m *GetDealReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDealReply.Marshal(b, m, deterministic)
+}
+func (m *GetDealReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDealReply.Merge(m, src)
+}
+func (m *GetDealReply) XXX_Size() int {
+	return xxx_messageInfo_GetDealReply.Size(m)
+}
+func (m *GetDealReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDealReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDealReply proto.InternalMessageInfo
+
+func (m *GetDealReply) GetRecord() *DealRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+type Profile struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReplicationFactor    int32          `protobuf:"varint,2,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	MaxPrice             uint64         `protobuf:"varint,3,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	Duration             uint64         `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	ExcludedCountries    []string       `protobuf:"bytes,5,rep,name=excludedCountries,proto3" json:"excludedCountries,omitempty"`
+	MinReputation        int32          `protobuf:"varint,6,opt,name=minReputation,proto3" json:"minReputation,omitempty"`
+	RenewalPolicy        *RenewalPolicy `protobuf:"bytes,7,opt,name=renewalPolicy,proto3" json:"renewalPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{43}
+}
+
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (m *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(m, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Profile) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *Profile) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *Profile) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *Profile) GetExcludedCountries() []string {
+	if m != nil {
+		return m.ExcludedCountries
+	}
+	return nil
+}
+
+func (m *Profile) GetMinReputation() int32 {
+	if m != nil {
+		return m.MinReputation
+	}
+	return 0
+}
+
+func (m *Profile) GetRenewalPolicy() *RenewalPolicy {
+	if m != nil {
+		return m.RenewalPolicy
+	}
+	return nil
+}
+
+type StoreProfileParams struct {
+	Address              string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Profile              string       `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	RetryPolicy          *RetryPolicy `protobuf:"bytes,3,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	ChunkSize            uint64       `protobuf:"varint,4,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	Encryption           *Encryption  `protobuf:"bytes,5,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StoreProfileParams) Reset()         { *m = StoreProfileParams{} }
+func (m *StoreProfileParams) String() string { return proto.CompactTextString(m) }
+func (*StoreProfileParams) ProtoMessage()    {}
+func (*StoreProfileParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{44}
+}
+
+func (m *StoreProfileParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreProfileParams.Unmarshal(m, b)
+}
+func (m *StoreProfileParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreProfileParams.Marshal(b, m, deterministic)
+}
+func (m *StoreProfileParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProfileParams.Merge(m, src)
+}
+func (m *StoreProfileParams) XXX_Size() int {
+	return xxx_messageInfo_StoreProfileParams.Size(m)
+}
+func (m *StoreProfileParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProfileParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProfileParams proto.InternalMessageInfo
+
+func (m *StoreProfileParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StoreProfileParams) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *StoreProfileParams) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *StoreProfileParams) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *StoreProfileParams) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+type StoreProfileRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*StoreProfileRequest_StoreProfileParams
+	//	*StoreProfileRequest_Chunk
+	Payload              isStoreProfileRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *StoreProfileRequest) Reset()         { *m = StoreProfileRequest{} }
+func (m *StoreProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreProfileRequest) ProtoMessage()    {}
+func (*StoreProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{45}
+}
+
+func (m *StoreProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreProfileRequest.Unmarshal(m, b)
+}
+func (m *StoreProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *StoreProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreProfileRequest.Merge(m, src)
+}
+func (m *StoreProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_StoreProfileRequest.Size(m)
+}
+func (m *StoreProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreProfileRequest proto.InternalMessageInfo
+
+type isStoreProfileRequest_Payload interface {
+	isStoreProfileRequest_Payload()
+}
+
+type StoreProfileRequest_StoreProfileParams struct {
+	StoreProfileParams *StoreProfileParams `protobuf:"bytes,1,opt,name=storeProfileParams,proto3,oneof"`
+}
+
+type StoreProfileRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*StoreProfileRequest_StoreProfileParams) isStoreProfileRequest_Payload() {}
+
+func (*StoreProfileRequest_Chunk) isStoreProfileRequest_Payload() {}
+
+func (m *StoreProfileRequest) GetPayload() isStoreProfileRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *StoreProfileRequest) GetStoreProfileParams() *StoreProfileParams {
+	if x, ok := m.GetPayload().(*StoreProfileRequest_StoreProfileParams); ok {
+		return x.StoreProfileParams
+	}
+	return nil
+}
+
+func (m *StoreProfileRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*StoreProfileRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StoreProfileRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StoreProfileRequest_StoreProfileParams)(nil),
+		(*StoreProfileRequest_Chunk)(nil),
+	}
+}
+
+type CreateProfileRequest struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProfileRequest) Reset()         { *m = CreateProfileRequest{} }
+func (m *CreateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProfileRequest) ProtoMessage()    {}
+func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{46}
+}
+
+func (m *CreateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProfileRequest.Unmarshal(m, b)
+}
+func (m *CreateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProfileRequest.Merge(m, src)
+}
+func (m *CreateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProfileRequest.Size(m)
+}
+func (m *CreateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProfileRequest proto.InternalMessageInfo
+
+func (m *CreateProfileRequest) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type CreateProfileReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProfileReply) Reset()         { *m = CreateProfileReply{} }
+func (m *CreateProfileReply) String() string { return proto.CompactTextString(m) }
+func (*CreateProfileReply) ProtoMessage()    {}
+func (*CreateProfileReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{47}
+}
+
+func (m *CreateProfileReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProfileReply.Unmarshal(m, b)
+}
+func (m *CreateProfileReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProfileReply.Marshal(b, m, deterministic)
+}
+func (m *CreateProfileReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProfileReply.Merge(m, src)
+}
+func (m *CreateProfileReply) XXX_Size() int {
+	return xxx_messageInfo_CreateProfileReply.Size(m)
+}
+func (m *CreateProfileReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProfileReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProfileReply proto.InternalMessageInfo
+
+type UpdateProfileRequest struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileRequest) Reset()         { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()    {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{48}
+}
+
+func (m *UpdateProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileRequest.Unmarshal(m, b)
+}
+func (m *UpdateProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileRequest.Merge(m, src)
+}
+func (m *UpdateProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileRequest.Size(m)
+}
+func (m *UpdateProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileRequest proto.InternalMessageInfo
+
+func (m *UpdateProfileRequest) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type UpdateProfileReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileReply) Reset()         { *m = UpdateProfileReply{} }
+func (m *UpdateProfileReply) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileReply) ProtoMessage()    {}
+func (*UpdateProfileReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{49}
+}
+
+func (m *UpdateProfileReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileReply.Unmarshal(m, b)
+}
+func (m *UpdateProfileReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileReply.Marshal(b, m, deterministic)
+}
+func (m *UpdateProfileReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileReply.Merge(m, src)
+}
+func (m *UpdateProfileReply) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileReply.Size(m)
+}
+func (m *UpdateProfileReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileReply proto.InternalMessageInfo
+
+type GetProfileRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProfileRequest) Reset()         { *m = GetProfileRequest{} }
+func (m *GetProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProfileRequest) ProtoMessage()    {}
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{50}
+}
+
+func (m *GetProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileRequest.Unmarshal(m, b)
+}
+func (m *GetProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileRequest.Merge(m, src)
+}
+func (m *GetProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProfileRequest.Size(m)
+}
+func (m *GetProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProfileRequest proto.InternalMessageInfo
+
+func (m *GetProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetProfileReply struct {
+	Profile              *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProfileReply) Reset()         { *m = GetProfileReply{} }
+func (m *GetProfileReply) String() string { return proto.CompactTextString(m) }
+func (*GetProfileReply) ProtoMessage()    {}
+func (*GetProfileReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{51}
+}
+
+func (m *GetProfileReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileReply.Unmarshal(m, b)
+}
+func (m *GetProfileReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileReply.Marshal(b, m, deterministic)
+}
+func (m *GetProfileReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileReply.Merge(m, src)
+}
+func (m *GetProfileReply) XXX_Size() int {
+	return xxx_messageInfo_GetProfileReply.Size(m)
+}
+func (m *GetProfileReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProfileReply proto.InternalMessageInfo
+
+func (m *GetProfileReply) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+type ListProfilesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProfilesRequest) Reset()         { *m = ListProfilesRequest{} }
+func (m *ListProfilesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProfilesRequest) ProtoMessage()    {}
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{52}
+}
+
+func (m *ListProfilesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProfilesRequest.Unmarshal(m, b)
+}
+func (m *ListProfilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProfilesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProfilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfilesRequest.Merge(m, src)
+}
+func (m *ListProfilesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProfilesRequest.Size(m)
+}
+func (m *ListProfilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProfilesRequest proto.InternalMessageInfo
+
+type ListProfilesReply struct {
+	Profiles             []*Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListProfilesReply) Reset()         { *m = ListProfilesReply{} }
+func (m *ListProfilesReply) String() string { return proto.CompactTextString(m) }
+func (*ListProfilesReply) ProtoMessage()    {}
+func (*ListProfilesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{53}
+}
+
+func (m *ListProfilesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProfilesReply.Unmarshal(m, b)
+}
+func (m *ListProfilesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProfilesReply.Marshal(b, m, deterministic)
+}
+func (m *ListProfilesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProfilesReply.Merge(m, src)
+}
+func (m *ListProfilesReply) XXX_Size() int {
+	return xxx_messageInfo_ListProfilesReply.Size(m)
+}
+func (m *ListProfilesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProfilesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProfilesReply proto.InternalMessageInfo
+
+func (m *ListProfilesReply) GetProfiles() []*Profile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+type DeleteProfileRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProfileRequest) Reset()         { *m = DeleteProfileRequest{} }
+func (m *DeleteProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteProfileRequest) ProtoMessage()    {}
+func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{54}
+}
+
+func (m *DeleteProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProfileRequest.Unmarshal(m, b)
+}
+func (m *DeleteProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProfileRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProfileRequest.Merge(m, src)
+}
+func (m *DeleteProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteProfileRequest.Size(m)
+}
+func (m *DeleteProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProfileRequest proto.InternalMessageInfo
+
+func (m *DeleteProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteProfileReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProfileReply) Reset()         { *m = DeleteProfileReply{} }
+func (m *DeleteProfileReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteProfileReply) ProtoMessage()    {}
+func (*DeleteProfileReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{55}
+}
+
+func (m *DeleteProfileReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProfileReply.Unmarshal(m, b)
+}
+func (m *DeleteProfileReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProfileReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteProfileReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProfileReply.Merge(m, src)
+}
+func (m *DeleteProfileReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteProfileReply.Size(m)
+}
+func (m *DeleteProfileReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProfileReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProfileReply proto.InternalMessageInfo
+
+type Webhook struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Proposals            []string `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Secret               []byte   `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{56}
+}
+
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetProposals() []string {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *Webhook) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type Delivery struct {
+	Id                   string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookID            string    `protobuf:"bytes,2,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Info                 *DealInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Attempts             int32     `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastAttempt          int64     `protobuf:"varint,5,opt,name=lastAttempt,proto3" json:"lastAttempt,omitempty"`
+	Delivered            bool      `protobuf:"varint,6,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	StatusCode           int32     `protobuf:"varint,7,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Error                string    `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Delivery) Reset()         { *m = Delivery{} }
+func (m *Delivery) String() string { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()    {}
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{57}
+}
+
+func (m *Delivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delivery.Unmarshal(m, b)
+}
+func (m *Delivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delivery.Marshal(b, m, deterministic)
+}
+func (m *Delivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delivery.Merge(m, src)
+}
+func (m *Delivery) XXX_Size() int {
+	return xxx_messageInfo_Delivery.Size(m)
+}
+func (m *Delivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delivery proto.InternalMessageInfo
+
+func (m *Delivery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Delivery) GetWebhookID() string {
+	if m != nil {
+		return m.WebhookID
+	}
+	return ""
+}
+
+func (m *Delivery) GetInfo() *DealInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *Delivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Delivery) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *Delivery) GetDelivered() bool {
+	if m != nil {
+		return m.Delivered
+	}
+	return false
+}
+
+func (m *Delivery) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *Delivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RegisterWebhookRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Proposals            []string `protobuf:"bytes,2,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterWebhookRequest) Reset()         { *m = RegisterWebhookRequest{} }
+func (m *RegisterWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterWebhookRequest) ProtoMessage()    {}
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{58}
+}
+
+func (m *RegisterWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterWebhookRequest.Unmarshal(m, b)
+}
+func (m *RegisterWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterWebhookRequest.Merge(m, src)
+}
+func (m *RegisterWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterWebhookRequest.Size(m)
+}
+func (m *RegisterWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterWebhookRequest proto.InternalMessageInfo
+
+func (m *RegisterWebhookRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RegisterWebhookRequest) GetProposals() []string {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *RegisterWebhookRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RegisterWebhookReply struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterWebhookReply) Reset()         { *m = RegisterWebhookReply{} }
+func (m *RegisterWebhookReply) String() string { return proto.CompactTextString(m) }
+func (*RegisterWebhookReply) ProtoMessage()    {}
+func (*RegisterWebhookReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{59}
+}
+
+func (m *RegisterWebhookReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterWebhookReply.Unmarshal(m, b)
+}
+func (m *RegisterWebhookReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterWebhookReply.Marshal(b, m, deterministic)
+}
+func (m *RegisterWebhookReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterWebhookReply.Merge(m, src)
+}
+func (m *RegisterWebhookReply) XXX_Size() int {
+	return xxx_messageInfo_RegisterWebhookReply.Size(m)
+}
+func (m *RegisterWebhookReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterWebhookReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterWebhookReply proto.InternalMessageInfo
+
+func (m *RegisterWebhookReply) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhooksRequest) Reset()         { *m = ListWebhooksRequest{} }
+func (m *ListWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksRequest) ProtoMessage()    {}
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{60}
+}
+
+func (m *ListWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksRequest.Unmarshal(m, b)
+}
+func (m *ListWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksRequest.Merge(m, src)
+}
+func (m *ListWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksRequest.Size(m)
+}
+func (m *ListWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksRequest proto.InternalMessageInfo
+
+type ListWebhooksReply struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListWebhooksReply) Reset()         { *m = ListWebhooksReply{} }
+func (m *ListWebhooksReply) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksReply) ProtoMessage()    {}
+func (*ListWebhooksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{61}
+}
+
+func (m *ListWebhooksReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksReply.Unmarshal(m, b)
+}
+func (m *ListWebhooksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksReply.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksReply.Merge(m, src)
+}
+func (m *ListWebhooksReply) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksReply.Size(m)
+}
+func (m *ListWebhooksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksReply proto.InternalMessageInfo
+
+func (m *ListWebhooksReply) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type UnregisterWebhookRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnregisterWebhookRequest) Reset()         { *m = UnregisterWebhookRequest{} }
+func (m *UnregisterWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterWebhookRequest) ProtoMessage()    {}
+func (*UnregisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{62}
+}
+
+func (m *UnregisterWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterWebhookRequest.Unmarshal(m, b)
+}
+func (m *UnregisterWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *UnregisterWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterWebhookRequest.Merge(m, src)
+}
+func (m *UnregisterWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_UnregisterWebhookRequest.Size(m)
+}
+func (m *UnregisterWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnregisterWebhookRequest proto.InternalMessageInfo
+
+func (m *UnregisterWebhookRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UnregisterWebhookReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnregisterWebhookReply) Reset()         { *m = UnregisterWebhookReply{} }
+func (m *UnregisterWebhookReply) String() string { return proto.CompactTextString(m) }
+func (*UnregisterWebhookReply) ProtoMessage()    {}
+func (*UnregisterWebhookReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{63}
+}
+
+func (m *UnregisterWebhookReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterWebhookReply.Unmarshal(m, b)
+}
+func (m *UnregisterWebhookReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterWebhookReply.Marshal(b, m, deterministic)
+}
+func (m *UnregisterWebhookReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterWebhookReply.Merge(m, src)
+}
+func (m *UnregisterWebhookReply) XXX_Size() int {
+	return xxx_messageInfo_UnregisterWebhookReply.Size(m)
+}
+func (m *UnregisterWebhookReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterWebhookReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnregisterWebhookReply proto.InternalMessageInfo
+
+type ListDeliveriesRequest struct {
+	WebhookID            string   `protobuf:"bytes,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeliveriesRequest) Reset()         { *m = ListDeliveriesRequest{} }
+func (m *ListDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeliveriesRequest) ProtoMessage()    {}
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{64}
+}
+
+func (m *ListDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeliveriesRequest.Unmarshal(m, b)
+}
+func (m *ListDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeliveriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeliveriesRequest.Merge(m, src)
+}
+func (m *ListDeliveriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeliveriesRequest.Size(m)
+}
+func (m *ListDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListDeliveriesRequest) GetWebhookID() string {
+	if m != nil {
+		return m.WebhookID
+	}
+	return ""
+}
+
+type ListDeliveriesReply struct {
+	Deliveries           []*Delivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListDeliveriesReply) Reset()         { *m = ListDeliveriesReply{} }
+func (m *ListDeliveriesReply) String() string { return proto.CompactTextString(m) }
+func (*ListDeliveriesReply) ProtoMessage()    {}
+func (*ListDeliveriesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{65}
+}
+
+func (m *ListDeliveriesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeliveriesReply.Unmarshal(m, b)
+}
+func (m *ListDeliveriesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeliveriesReply.Marshal(b, m, deterministic)
+}
+func (m *ListDeliveriesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeliveriesReply.Merge(m, src)
+}
+func (m *ListDeliveriesReply) XXX_Size() int {
+	return xxx_messageInfo_ListDeliveriesReply.Size(m)
+}
+func (m *ListDeliveriesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeliveriesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeliveriesReply proto.InternalMessageInfo
+
+func (m *ListDeliveriesReply) GetDeliveries() []*Delivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("filecoin.deals.pb.SortOrder", SortOrder_name, SortOrder_value)
+	proto.RegisterEnum("filecoin.deals.pb.AskChangeType", AskChangeType_name, AskChangeType_value)
+	proto.RegisterEnum("filecoin.deals.pb.FailureReason", FailureReason_name, FailureReason_value)
+	proto.RegisterType((*Query)(nil), "filecoin.deals.pb.Query")
+	proto.RegisterType((*StorageAsk)(nil), "filecoin.deals.pb.StorageAsk")
+	proto.RegisterType((*DealConfig)(nil), "filecoin.deals.pb.DealConfig")
+	proto.RegisterType((*DealInfo)(nil), "filecoin.deals.pb.DealInfo")
+	proto.RegisterType((*DealRecord)(nil), "filecoin.deals.pb.DealRecord")
+	proto.RegisterType((*EncryptionParams)(nil), "filecoin.deals.pb.EncryptionParams")
+	proto.RegisterType((*AvailableAsksRequest)(nil), "filecoin.deals.pb.AvailableAsksRequest")
+	proto.RegisterType((*AvailableAsksReply)(nil), "filecoin.deals.pb.AvailableAsksReply")
+	proto.RegisterType((*WatchAsksRequest)(nil), "filecoin.deals.pb.WatchAsksRequest")
+	proto.RegisterType((*AskChange)(nil), "filecoin.deals.pb.AskChange")
+	proto.RegisterType((*AskHistoryRequest)(nil), "filecoin.deals.pb.AskHistoryRequest")
+	proto.RegisterType((*PricePoint)(nil), "filecoin.deals.pb.PricePoint")
+	proto.RegisterType((*DailyPrice)(nil), "filecoin.deals.pb.DailyPrice")
+	proto.RegisterType((*AskHistoryReply)(nil), "filecoin.deals.pb.AskHistoryReply")
+	proto.RegisterType((*RetryPolicy)(nil), "filecoin.deals.pb.RetryPolicy")
+	proto.RegisterType((*RenewalPolicy)(nil), "filecoin.deals.pb.RenewalPolicy")
+	proto.RegisterType((*Encryption)(nil), "filecoin.deals.pb.Encryption")
+	proto.RegisterType((*StoreParams)(nil), "filecoin.deals.pb.StoreParams")
+	proto.RegisterType((*StoreRequest)(nil), "filecoin.deals.pb.StoreRequest")
+	proto.RegisterType((*StoreAutoParams)(nil), "filecoin.deals.pb.StoreAutoParams")
+	proto.RegisterType((*StoreAutoRequest)(nil), "filecoin.deals.pb.StoreAutoRequest")
+	proto.RegisterType((*FailedDeal)(nil), "filecoin.deals.pb.FailedDeal")
+	proto.RegisterType((*StoreReply)(nil), "filecoin.deals.pb.StoreReply")
+	proto.RegisterType((*WatchRequest)(nil), "filecoin.deals.pb.WatchRequest")
+	proto.RegisterType((*WatchSummary)(nil), "filecoin.deals.pb.WatchSummary")
+	proto.RegisterType((*WatchReply)(nil), "filecoin.deals.pb.WatchReply")
+	proto.RegisterType((*RetrieveRequest)(nil), "filecoin.deals.pb.RetrieveRequest")
+	proto.RegisterType((*RetrieveReply)(nil), "filecoin.deals.pb.RetrieveReply")
+	proto.RegisterType((*BatchFileHeader)(nil), "filecoin.deals.pb.BatchFileHeader")
+	proto.RegisterType((*StoreBatchRequest)(nil), "filecoin.deals.pb.StoreBatchRequest")
+	proto.RegisterType((*BatchEntry)(nil), "filecoin.deals.pb.BatchEntry")
+	proto.RegisterType((*Batch)(nil), "filecoin.deals.pb.Batch")
+	proto.RegisterType((*StoreBatchReply)(nil), "filecoin.deals.pb.StoreBatchReply")
+	proto.RegisterType((*GetBatchRequest)(nil), "filecoin.deals.pb.GetBatchRequest")
+	proto.RegisterType((*GetBatchReply)(nil), "filecoin.deals.pb.GetBatchReply")
+	proto.RegisterType((*RetrieveFileRequest)(nil), "filecoin.deals.pb.RetrieveFileRequest")
+	proto.RegisterType((*EstimateRequest)(nil), "filecoin.deals.pb.EstimateRequest")
+	proto.RegisterType((*DealEstimation)(nil), "filecoin.deals.pb.DealEstimation")
+	proto.RegisterType((*EstimateReply)(nil), "filecoin.deals.pb.EstimateReply")
+	proto.RegisterType((*ListDealsRequest)(nil), "filecoin.deals.pb.ListDealsRequest")
+	proto.RegisterType((*ListDealsReply)(nil), "filecoin.deals.pb.ListDealsReply")
+	proto.RegisterType((*GetDealRequest)(nil), "filecoin.deals.pb.GetDealRequest")
+	proto.RegisterType((*GetDealReply)(nil), "filecoin.deals.pb.GetDealReply")
+	proto.RegisterType((*Profile)(nil), "filecoin.deals.pb.Profile")
+	proto.RegisterType((*StoreProfileParams)(nil), "filecoin.deals.pb.StoreProfileParams")
+	proto.RegisterType((*StoreProfileRequest)(nil), "filecoin.deals.pb.StoreProfileRequest")
+	proto.RegisterType((*CreateProfileRequest)(nil), "filecoin.deals.pb.CreateProfileRequest")
+	proto.RegisterType((*CreateProfileReply)(nil), "filecoin.deals.pb.CreateProfileReply")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "filecoin.deals.pb.UpdateProfileRequest")
+	proto.RegisterType((*UpdateProfileReply)(nil), "filecoin.deals.pb.UpdateProfileReply")
+	proto.RegisterType((*GetProfileRequest)(nil), "filecoin.deals.pb.GetProfileRequest")
+	proto.RegisterType((*GetProfileReply)(nil), "filecoin.deals.pb.GetProfileReply")
+	proto.RegisterType((*ListProfilesRequest)(nil), "filecoin.deals.pb.ListProfilesRequest")
+	proto.RegisterType((*ListProfilesReply)(nil), "filecoin.deals.pb.ListProfilesReply")
+	proto.RegisterType((*DeleteProfileRequest)(nil), "filecoin.deals.pb.DeleteProfileRequest")
+	proto.RegisterType((*DeleteProfileReply)(nil), "filecoin.deals.pb.DeleteProfileReply")
+	proto.RegisterType((*Webhook)(nil), "filecoin.deals.pb.Webhook")
+	proto.RegisterType((*Delivery)(nil), "filecoin.deals.pb.Delivery")
+	proto.RegisterType((*RegisterWebhookRequest)(nil), "filecoin.deals.pb.RegisterWebhookRequest")
+	proto.RegisterType((*RegisterWebhookReply)(nil), "filecoin.deals.pb.RegisterWebhookReply")
+	proto.RegisterType((*ListWebhooksRequest)(nil), "filecoin.deals.pb.ListWebhooksRequest")
+	proto.RegisterType((*ListWebhooksReply)(nil), "filecoin.deals.pb.ListWebhooksReply")
+	proto.RegisterType((*UnregisterWebhookRequest)(nil), "filecoin.deals.pb.UnregisterWebhookRequest")
+	proto.RegisterType((*UnregisterWebhookReply)(nil), "filecoin.deals.pb.UnregisterWebhookReply")
+	proto.RegisterType((*ListDeliveriesRequest)(nil), "filecoin.deals.pb.ListDeliveriesRequest")
+	proto.RegisterType((*ListDeliveriesReply)(nil), "filecoin.deals.pb.ListDeliveriesReply")
+}
+
+func init() { proto.RegisterFile("deals.proto", fileDescriptor_71783c876a92172d) }
+
+var fileDescriptor_71783c876a92172d = []byte{
+	// 3056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x73, 0xe3, 0xc6,
+	0xb1, 0x17, 0x08, 0x7e, 0x36, 0x45, 0x89, 0x9a, 0x95, 0xb7, 0x58, 0x7c, 0xfb, 0x6c, 0x1a, 0x5e,
+	0xd9, 0xf2, 0xbe, 0x57, 0xb2, 0x23, 0x7f, 0xa6, 0x52, 0x29, 0x87, 0x92, 0xa8, 0x95, 0xbc, 0x5a,
+	0x89, 0x19, 0xed, 0x87, 0x93, 0x4a, 0x79, 0x03, 0x11, 0x43, 0x09, 0x25, 0x10, 0xa0, 0x01, 0x50,
+	0xbb, 0x4c, 0xe5, 0x90, 0x4b, 0xae, 0xa9, 0xe4, 0x90, 0x43, 0x72, 0x71, 0x55, 0xae, 0xa9, 0xf8,
+	0x94, 0x7f, 0x20, 0xf7, 0xfc, 0x1f, 0xa9, 0x4a, 0x6e, 0xc9, 0x31, 0x97, 0x54, 0xcf, 0x0c, 0x80,
+	0x01, 0x04, 0x92, 0x5a, 0x67, 0x6f, 0x9c, 0x46, 0x4f, 0x4f, 0x4f, 0x77, 0x4f, 0xf7, 0xaf, 0x67,
+	0x08, 0x75, 0x8b, 0x99, 0x4e, 0xb0, 0x35, 0xf6, 0xbd, 0xd0, 0x23, 0x6b, 0x43, 0xdb, 0x61, 0x03,
+	0xcf, 0x76, 0xb7, 0x24, 0xf5, 0xcc, 0xf8, 0x57, 0x01, 0x4a, 0x3f, 0x9c, 0x30, 0x7f, 0x4a, 0xda,
+	0x50, 0x7d, 0x68, 0xbe, 0xe8, 0xfb, 0xf6, 0x80, 0xb5, 0xb4, 0x8e, 0xb6, 0x59, 0xa4, 0xf1, 0x98,
+	0xdc, 0x81, 0x5a, 0xdf, 0x66, 0x03, 0x76, 0x6a, 0xff, 0x8c, 0xb5, 0x0a, 0xfc, 0x63, 0x42, 0x20,
+	0xeb, 0x50, 0x3a, 0xb2, 0x47, 0x76, 0xd8, 0xd2, 0x3b, 0xda, 0x66, 0x89, 0x8a, 0x01, 0xb9, 0x0d,
+	0xe5, 0x93, 0xe1, 0x30, 0x60, 0x61, 0xab, 0xc8, 0xc9, 0x72, 0x44, 0xde, 0x86, 0x95, 0xde, 0x8b,
+	0x81, 0x33, 0xb1, 0x98, 0xf5, 0xd0, 0x76, 0x99, 0x1f, 0xb4, 0x4a, 0x1d, 0x7d, 0xb3, 0x46, 0x33,
+	0x54, 0xe4, 0x3b, 0x74, 0x53, 0x7c, 0x65, 0xc1, 0x97, 0xa6, 0x92, 0xd7, 0x01, 0x8e, 0xbd, 0xb0,
+	0xf7, 0x62, 0x6c, 0xfb, 0xcc, 0x6a, 0x55, 0x3a, 0xda, 0x66, 0x95, 0x2a, 0x14, 0xbe, 0x2f, 0xdb,
+	0xed, 0x7b, 0xcf, 0x99, 0xdf, 0xaa, 0xca, 0x7d, 0xc9, 0x31, 0xee, 0x6b, 0xd7, 0x9b, 0xb8, 0xa1,
+	0x6f, 0xb3, 0xa0, 0x55, 0xe3, 0xe2, 0x13, 0x02, 0xf9, 0x10, 0xca, 0xa7, 0x9e, 0x1f, 0xee, 0x4c,
+	0x5b, 0xd0, 0xd1, 0x36, 0x57, 0xb6, 0xef, 0x6c, 0x5d, 0xb3, 0xdf, 0x16, 0x32, 0x9c, 0xf8, 0x16,
+	0xf3, 0xa9, 0xe4, 0x25, 0x06, 0x2c, 0x9f, 0xb8, 0xce, 0xf4, 0x09, 0xf3, 0xed, 0xa1, 0xcd, 0xac,
+	0x56, 0x9d, 0x6b, 0x94, 0xa2, 0x19, 0xdf, 0x68, 0x00, 0xa7, 0xa1, 0xe7, 0x9b, 0xe7, 0xac, 0x1b,
+	0x5c, 0xa2, 0x01, 0xc7, 0x8a, 0xdd, 0xc5, 0x00, 0x05, 0x8d, 0x6c, 0x37, 0x6b, 0xf7, 0x14, 0x0d,
+	0x67, 0x8e, 0xd0, 0x0c, 0xdc, 0xf4, 0x35, 0x2a, 0x06, 0xb8, 0xad, 0xd0, 0x1e, 0xb1, 0x20, 0x34,
+	0x47, 0x63, 0x6e, 0xfd, 0x22, 0x4d, 0x08, 0xe8, 0x18, 0x86, 0xb6, 0x99, 0xb6, 0x4a, 0xfc, 0x93,
+	0x1c, 0xa1, 0xa1, 0xae, 0x22, 0xa5, 0xcb, 0x5c, 0xe9, 0x78, 0x6c, 0x1c, 0x00, 0xec, 0x31, 0xd3,
+	0xd9, 0xf5, 0xdc, 0xa1, 0x7d, 0x9e, 0xac, 0xaa, 0xa9, 0xab, 0xbe, 0x0e, 0xc0, 0xc6, 0xde, 0xe0,
+	0x42, 0x84, 0x90, 0x50, 0x48, 0xa1, 0x7c, 0x5e, 0xac, 0x16, 0x9a, 0xba, 0xf1, 0x4d, 0x01, 0xaa,
+	0x28, 0xea, 0xd0, 0x1d, 0x7a, 0xa4, 0x03, 0xf5, 0xb1, 0xef, 0x8d, 0xbd, 0xc0, 0x74, 0x76, 0x6d,
+	0x4b, 0x8a, 0x53, 0x49, 0xa4, 0x05, 0x95, 0x20, 0x34, 0x43, 0x76, 0xb8, 0x27, 0xf7, 0x1f, 0x0d,
+	0x71, 0x93, 0xfc, 0xe7, 0xb1, 0x39, 0x8a, 0x56, 0x4b, 0x08, 0x89, 0x8a, 0x45, 0x55, 0xc5, 0x36,
+	0x54, 0xc7, 0x68, 0x3b, 0xca, 0x86, 0x7c, 0xf3, 0xcb, 0x34, 0x1e, 0x13, 0x02, 0xc5, 0x00, 0xcd,
+	0x5c, 0xe6, 0xcb, 0xf0, 0xdf, 0xe4, 0x2e, 0x34, 0xb8, 0x2f, 0xfa, 0xcc, 0xef, 0xe1, 0x46, 0xb8,
+	0x33, 0x6b, 0x34, 0x4d, 0x44, 0xa9, 0xd6, 0xc4, 0x37, 0x43, 0xdb, 0x73, 0xa3, 0x08, 0x8b, 0xc6,
+	0xa8, 0xe5, 0x64, 0x6c, 0x99, 0x21, 0xb3, 0xba, 0x61, 0xab, 0xd6, 0xd1, 0x36, 0x75, 0x9a, 0x10,
+	0xf0, 0xab, 0xcf, 0x5c, 0xf6, 0x9c, 0x59, 0x32, 0xc8, 0x6a, 0x34, 0x21, 0x7c, 0x5e, 0xac, 0x56,
+	0x9a, 0x55, 0xe3, 0x9f, 0xba, 0xb0, 0x3d, 0x65, 0x03, 0xcf, 0xb7, 0x6e, 0x66, 0x32, 0xcb, 0x0c,
+	0x4d, 0xfc, 0x5a, 0xe0, 0x5f, 0xa3, 0x21, 0x7e, 0x31, 0x2d, 0xcb, 0x67, 0x41, 0x20, 0x0d, 0x16,
+	0x0d, 0x67, 0x98, 0x2b, 0xed, 0xd1, 0xd5, 0xac, 0x47, 0x53, 0x1b, 0x2f, 0x5f, 0xdf, 0xf8, 0xc0,
+	0x67, 0x72, 0xe3, 0x15, 0xb1, 0xf1, 0x98, 0x40, 0xde, 0x83, 0xa2, 0xed, 0x0e, 0x3d, 0x6e, 0xae,
+	0xfa, 0xf6, 0xff, 0xe4, 0x1c, 0xac, 0x28, 0x46, 0x28, 0x67, 0x24, 0x9b, 0xb0, 0x6a, 0x0e, 0x42,
+	0xfb, 0x8a, 0x0b, 0x17, 0xbe, 0xa8, 0xf1, 0x15, 0xb3, 0xe4, 0xd8, 0xa6, 0xa6, 0x73, 0x32, 0x4c,
+	0xd9, 0x14, 0x09, 0x69, 0x8b, 0xd7, 0x33, 0x16, 0x47, 0xe3, 0x8e, 0x4c, 0xd7, 0x1e, 0xb2, 0x20,
+	0x44, 0xf3, 0x2d, 0x0b, 0xe3, 0x2a, 0x24, 0xb2, 0x0b, 0xc0, 0xdc, 0x81, 0x3f, 0x1d, 0xf3, 0x4d,
+	0x37, 0xb8, 0xfa, 0x6f, 0xe5, 0xa8, 0xdf, 0x8b, 0x99, 0xfa, 0xa6, 0x6f, 0x8e, 0x02, 0xaa, 0x4c,
+	0x43, 0xbb, 0x9d, 0x99, 0xe1, 0xe0, 0x02, 0xd7, 0x58, 0xe1, 0x6b, 0xc4, 0xe3, 0xcf, 0x8b, 0xd5,
+	0x52, 0xb3, 0x6c, 0xfc, 0x14, 0x9a, 0x59, 0x09, 0xa8, 0xba, 0xe9, 0x9c, 0x7b, 0xbe, 0x1d, 0x5e,
+	0x8c, 0xa4, 0xdf, 0x13, 0x02, 0x59, 0x81, 0x82, 0x7d, 0xc5, 0x1d, 0xbe, 0x4c, 0x0b, 0xf6, 0x15,
+	0xfa, 0xee, 0xb9, 0x6f, 0x8e, 0xc7, 0xcc, 0x7a, 0xc0, 0xa6, 0xdc, 0xdd, 0xcb, 0x54, 0xa1, 0x18,
+	0xfb, 0xb0, 0xde, 0xbd, 0x32, 0x6d, 0xc7, 0x3c, 0x73, 0x30, 0x07, 0x05, 0x94, 0x7d, 0x35, 0x61,
+	0x41, 0x48, 0xb6, 0xa0, 0xf4, 0x15, 0xd6, 0x03, 0xbe, 0x42, 0x7d, 0xbb, 0x95, 0xb3, 0x37, 0x5e,
+	0x2f, 0xa8, 0x60, 0x33, 0xee, 0x03, 0xc9, 0xc8, 0x19, 0x3b, 0x53, 0xf2, 0x1d, 0x28, 0x9a, 0xc1,
+	0x65, 0xd0, 0xd2, 0x3a, 0xfa, 0x66, 0x7d, 0xfb, 0x7f, 0xf3, 0x12, 0x67, 0x9c, 0xfe, 0x28, 0x67,
+	0x35, 0xee, 0x41, 0xf3, 0x29, 0x1a, 0x41, 0x55, 0xe6, 0x36, 0x94, 0x47, 0x22, 0xf7, 0x6b, 0x3c,
+	0x39, 0xcb, 0x91, 0xf1, 0x2b, 0x0d, 0x6a, 0xdd, 0xe0, 0x72, 0xf7, 0xc2, 0x74, 0xcf, 0x19, 0xf9,
+	0x10, 0x8a, 0xe1, 0x74, 0x2c, 0xb2, 0xe7, 0xca, 0x76, 0x27, 0x67, 0xb1, 0x98, 0xf7, 0xd1, 0x74,
+	0xcc, 0x28, 0xe7, 0x26, 0xef, 0x81, 0x6e, 0x06, 0x97, 0xdc, 0x62, 0x0b, 0x35, 0x44, 0x4e, 0xf4,
+	0x9a, 0xe7, 0x58, 0x49, 0x76, 0x2b, 0xd2, 0x78, 0x6c, 0x3c, 0x84, 0xb5, 0x6e, 0x70, 0x79, 0x60,
+	0x07, 0xa1, 0xe7, 0x4f, 0x23, 0xed, 0xf3, 0xd3, 0x24, 0x81, 0xe2, 0xd0, 0xf7, 0x46, 0x7c, 0x61,
+	0x9d, 0xf2, 0xdf, 0xe8, 0xbc, 0xd0, 0xe3, 0x42, 0x75, 0x5a, 0x08, 0x3d, 0xe3, 0x08, 0x80, 0xcb,
+	0xed, 0x7b, 0xb6, 0x3b, 0x4b, 0x4e, 0x5c, 0x34, 0x0a, 0x6a, 0xd1, 0x20, 0x50, 0xc4, 0x4c, 0x2f,
+	0x65, 0xf1, 0xdf, 0xc6, 0xc7, 0x00, 0x7b, 0xa6, 0xed, 0x4c, 0xc5, 0xa1, 0x6d, 0x82, 0x6e, 0x99,
+	0xc2, 0xbd, 0x3a, 0xc5, 0x9f, 0xdc, 0xca, 0xcc, 0xb2, 0x4d, 0x57, 0x8a, 0x92, 0x23, 0xe3, 0x8f,
+	0x1a, 0xac, 0xaa, 0xbb, 0x42, 0xc7, 0x7e, 0x04, 0xe5, 0x31, 0x2a, 0x35, 0xcf, 0xb5, 0x89, 0xea,
+	0x54, 0x32, 0x93, 0x2e, 0x2c, 0x5b, 0xa8, 0xc2, 0x43, 0x2e, 0x39, 0x68, 0x15, 0x66, 0x4e, 0x4e,
+	0x34, 0xa5, 0xa9, 0x29, 0x18, 0xd0, 0x57, 0x9e, 0x63, 0x86, 0xb6, 0x63, 0x87, 0x22, 0xa0, 0x35,
+	0xaa, 0x50, 0x8c, 0xdf, 0x6a, 0x50, 0xa7, 0x2c, 0xf4, 0xa7, 0x7d, 0xcf, 0xb1, 0x07, 0xf2, 0x2c,
+	0xbf, 0xe8, 0x86, 0x21, 0x1b, 0x8d, 0xb9, 0xba, 0x08, 0x42, 0x54, 0x12, 0xa6, 0xc3, 0x33, 0x73,
+	0x70, 0xe9, 0x0d, 0x87, 0xd2, 0x19, 0xd1, 0x10, 0x8f, 0x1a, 0x37, 0xe7, 0xce, 0x64, 0x34, 0x96,
+	0xbe, 0x4e, 0x08, 0xe4, 0xff, 0x61, 0xcd, 0x67, 0x63, 0xc7, 0x1e, 0xf0, 0xac, 0xb3, 0x6f, 0x0e,
+	0x42, 0xcf, 0x97, 0x20, 0xe7, 0xfa, 0x07, 0xe3, 0x10, 0x1a, 0x54, 0xa4, 0x1f, 0xa9, 0x18, 0x56,
+	0xe7, 0x0b, 0x9f, 0x05, 0x17, 0x9e, 0x63, 0xc9, 0x8a, 0x9f, 0x10, 0x30, 0xca, 0x46, 0x11, 0x0c,
+	0x13, 0xee, 0x88, 0xc7, 0xc6, 0x1e, 0x40, 0x92, 0x15, 0x50, 0x7d, 0xe6, 0xe2, 0xb1, 0x13, 0x52,
+	0xaa, 0x34, 0x1a, 0x72, 0xf5, 0x27, 0x67, 0x8e, 0x3d, 0xc0, 0xa3, 0x2f, 0x52, 0x42, 0x42, 0x30,
+	0xfe, 0x51, 0x80, 0x3a, 0xc6, 0x36, 0x93, 0x79, 0x45, 0xa9, 0x0a, 0x5a, 0xba, 0x2a, 0x7c, 0x26,
+	0xe0, 0xa3, 0xa8, 0xfa, 0x73, 0x9d, 0x16, 0x73, 0x51, 0x75, 0x46, 0xaa, 0x40, 0xe8, 0x99, 0x02,
+	0xf1, 0x03, 0xa8, 0xfb, 0x89, 0xbb, 0xb8, 0xfd, 0xea, 0xdb, 0xaf, 0xe7, 0x08, 0x57, 0x9c, 0x4a,
+	0xd5, 0x29, 0x64, 0x1f, 0x1a, 0xbe, 0x6a, 0x59, 0x5e, 0xd2, 0xeb, 0xb9, 0x09, 0x20, 0xe5, 0x01,
+	0x9a, 0x9e, 0xc6, 0x4b, 0xd5, 0xc5, 0xc4, 0xbd, 0x3c, 0x4d, 0xca, 0x7f, 0x42, 0x20, 0xdf, 0x4f,
+	0x65, 0xfc, 0xca, 0xcc, 0x74, 0x91, 0x78, 0x46, 0xcd, 0xf5, 0xc6, 0x04, 0x96, 0xb9, 0xb1, 0xa3,
+	0xa4, 0xb0, 0x03, 0xf5, 0x20, 0x31, 0x7e, 0x4b, 0x9b, 0xb9, 0x6d, 0xc5, 0x45, 0x07, 0x4b, 0x54,
+	0x9d, 0x44, 0x6e, 0x43, 0x89, 0xeb, 0x27, 0x7c, 0x7b, 0xb0, 0x44, 0xc5, 0x70, 0xa7, 0x06, 0x95,
+	0xb1, 0x39, 0x75, 0x3c, 0xd3, 0x32, 0xfe, 0xae, 0xc3, 0x2a, 0x97, 0xd0, 0x9d, 0x84, 0xde, 0x42,
+	0x47, 0xe7, 0x46, 0x74, 0x61, 0x46, 0x44, 0xa7, 0x42, 0x54, 0x4f, 0x87, 0x28, 0x0f, 0xbd, 0x18,
+	0xb1, 0x4a, 0xe8, 0x19, 0x13, 0x52, 0xf1, 0x50, 0x9a, 0x1f, 0x0f, 0xe5, 0x57, 0x10, 0x0f, 0x95,
+	0x57, 0x10, 0x0f, 0xd5, 0xf9, 0xf1, 0x50, 0x7b, 0xc9, 0x78, 0x40, 0x53, 0x33, 0xd9, 0xe8, 0x24,
+	0xad, 0x07, 0xf0, 0xea, 0x76, 0xfd, 0x03, 0x02, 0xd0, 0x91, 0xed, 0x52, 0x36, 0x9e, 0x84, 0xc2,
+	0x6a, 0x75, 0xee, 0x94, 0x34, 0xd1, 0xf8, 0xa5, 0x06, 0xcd, 0xd8, 0xd9, 0x51, 0xa0, 0x1d, 0xc3,
+	0x6a, 0x90, 0x0e, 0x00, 0x19, 0x6c, 0xc6, 0xac, 0x60, 0x4b, 0x38, 0x0f, 0x96, 0x68, 0x76, 0xf2,
+	0x4d, 0x82, 0xee, 0x6b, 0x0d, 0x60, 0xdf, 0xb4, 0x1d, 0x66, 0x61, 0x42, 0x40, 0x4b, 0x25, 0xc9,
+	0xa0, 0xa5, 0xcd, 0xb4, 0x94, 0x92, 0x3d, 0x94, 0x09, 0xe4, 0x53, 0x28, 0xfb, 0xcc, 0x0c, 0x3c,
+	0x51, 0x96, 0xf2, 0x0b, 0x3b, 0xae, 0x36, 0xc1, 0xc3, 0x85, 0x7c, 0x54, 0xf2, 0x63, 0xa0, 0x8f,
+	0x58, 0x10, 0x98, 0xe7, 0x51, 0x63, 0x10, 0x0d, 0x8d, 0x3f, 0xc9, 0xc6, 0x8b, 0x89, 0x6a, 0x46,
+	0xa0, 0x38, 0xb0, 0xad, 0x08, 0x5d, 0xf0, 0xdf, 0x98, 0xf4, 0x86, 0xf1, 0x1e, 0x10, 0x28, 0xcf,
+	0x4a, 0x7a, 0xc9, 0x4e, 0xa9, 0x3a, 0x03, 0x7d, 0x96, 0xf8, 0x1b, 0x33, 0x70, 0x91, 0x67, 0xe0,
+	0x34, 0x31, 0x83, 0xcf, 0x4a, 0x59, 0x7c, 0x26, 0xbb, 0xa5, 0x5f, 0x6b, 0xb0, 0xcc, 0x51, 0x51,
+	0xe4, 0x55, 0x5e, 0x99, 0x04, 0xd6, 0x8f, 0xd4, 0x4e, 0x08, 0x88, 0x09, 0x02, 0xdb, 0x95, 0x95,
+	0x43, 0xa7, 0x62, 0xa0, 0xa0, 0x28, 0x5d, 0x45, 0x51, 0x48, 0xe7, 0x0d, 0x53, 0xd0, 0x2a, 0x76,
+	0x74, 0xac, 0xfb, 0x62, 0xc4, 0x7b, 0x16, 0x37, 0xb4, 0x9d, 0x3d, 0xcf, 0x65, 0x5c, 0xb3, 0x2a,
+	0x4d, 0x08, 0x46, 0x57, 0x6a, 0x74, 0x3a, 0x19, 0x8d, 0x4c, 0x1f, 0xa1, 0x5e, 0xc9, 0x62, 0x91,
+	0x36, 0x0b, 0xb0, 0xbc, 0xe0, 0x34, 0x7e, 0xa1, 0x01, 0xc8, 0x5d, 0xa1, 0x17, 0x3e, 0x81, 0xaa,
+	0x25, 0x39, 0x64, 0x94, 0xcc, 0x15, 0x12, 0x33, 0x93, 0xef, 0x42, 0x25, 0x10, 0x5a, 0x48, 0x18,
+	0xf7, 0x46, 0xce, 0x3c, 0x55, 0x59, 0x1a, 0xf1, 0x1b, 0x27, 0xb0, 0x8a, 0x79, 0xc4, 0x66, 0x57,
+	0x71, 0x66, 0x9e, 0x9d, 0x1e, 0x9b, 0xa0, 0x0f, 0xe2, 0x6e, 0x0a, 0x7f, 0x22, 0xe5, 0x32, 0x86,
+	0xd5, 0xf8, 0xd3, 0xd8, 0x80, 0x46, 0x22, 0x10, 0x77, 0xb5, 0x1e, 0x9d, 0x17, 0x8d, 0x33, 0x89,
+	0x81, 0xb1, 0x01, 0xab, 0x3b, 0xa8, 0xd0, 0xbe, 0xed, 0xb0, 0x03, 0x66, 0x5a, 0x02, 0x10, 0xba,
+	0xd8, 0xc3, 0x8a, 0x45, 0xf9, 0x6f, 0xe3, 0xcf, 0x1a, 0xac, 0xf1, 0x38, 0xdd, 0x51, 0x9d, 0xff,
+	0x2a, 0x6a, 0xc7, 0xa7, 0x50, 0x44, 0xfe, 0x56, 0x61, 0x66, 0x2e, 0xc8, 0xe8, 0x77, 0xb0, 0x44,
+	0xf9, 0x8c, 0x24, 0x01, 0xe8, 0x33, 0x13, 0xc0, 0x11, 0x00, 0x9f, 0xdd, 0x73, 0x43, 0x7f, 0x9a,
+	0xb7, 0x31, 0x8c, 0x39, 0x4f, 0xdc, 0x0a, 0x49, 0xac, 0x29, 0x46, 0x71, 0xf7, 0xad, 0x27, 0xdd,
+	0xb7, 0xf1, 0x7b, 0x0d, 0x4a, 0x5c, 0x5c, 0xe4, 0x00, 0x2d, 0x71, 0xc0, 0xec, 0x26, 0x37, 0x47,
+	0x12, 0xf9, 0x00, 0x4a, 0xb8, 0x05, 0x11, 0xe8, 0xf9, 0xa7, 0x39, 0xd1, 0x9b, 0x0a, 0xde, 0x74,
+	0x07, 0x5b, 0xca, 0x74, 0xb0, 0x18, 0xc3, 0xab, 0xaa, 0x87, 0xd0, 0xe5, 0x5b, 0x50, 0xe2, 0x7d,
+	0xdc, 0x9c, 0xde, 0x49, 0x70, 0x0b, 0x36, 0x4c, 0x90, 0x41, 0x9c, 0x8c, 0x16, 0x74, 0x22, 0x82,
+	0x89, 0x2a, 0x13, 0x8c, 0xb7, 0x60, 0xf5, 0x3e, 0x0b, 0x53, 0x11, 0x72, 0xcd, 0x50, 0xc6, 0x67,
+	0xd0, 0x48, 0x98, 0xbe, 0x85, 0x92, 0xc6, 0x57, 0x70, 0x2b, 0x0a, 0x6c, 0x0c, 0x8a, 0xc5, 0xa7,
+	0x45, 0xed, 0x6e, 0x0b, 0xe9, 0xee, 0x36, 0x0e, 0x09, 0x5d, 0x09, 0x09, 0x79, 0x96, 0x8a, 0xc9,
+	0x59, 0xfa, 0xab, 0x06, 0xab, 0xbd, 0x20, 0xb4, 0x47, 0x66, 0x18, 0xaf, 0x17, 0xb9, 0x55, 0x53,
+	0xdc, 0xaa, 0xc2, 0x89, 0x42, 0x06, 0x4e, 0x64, 0xb0, 0xab, 0xfe, 0xd2, 0xd8, 0xf5, 0xa5, 0x50,
+	0x7e, 0x0a, 0x13, 0x95, 0x32, 0xb0, 0xfd, 0xe7, 0xb0, 0x82, 0x8b, 0xc8, 0x1d, 0xa1, 0x72, 0x37,
+	0xb9, 0x40, 0x2b, 0x5d, 0xbb, 0x6e, 0xc1, 0x6a, 0xe5, 0x05, 0x61, 0x64, 0x3c, 0xfc, 0x8d, 0xeb,
+	0x3e, 0x37, 0x7d, 0xd7, 0x76, 0xcf, 0x45, 0x70, 0xd7, 0x68, 0x3c, 0x96, 0x25, 0xe4, 0x4b, 0x68,
+	0x24, 0xb6, 0x94, 0x89, 0x29, 0xf4, 0x42, 0xd3, 0x89, 0x16, 0xe7, 0x03, 0xf2, 0x49, 0x94, 0xc6,
+	0x05, 0xca, 0x7f, 0x73, 0x86, 0xa5, 0x92, 0x4d, 0x44, 0xc9, 0x9c, 0x40, 0xf3, 0xc8, 0x0e, 0x42,
+	0xfc, 0x18, 0xf5, 0xed, 0xc6, 0x21, 0xac, 0x28, 0x34, 0x91, 0xe3, 0x2b, 0x3e, 0xbf, 0xc0, 0x9a,
+	0xd7, 0x38, 0x26, 0xd7, 0x5c, 0x34, 0xe2, 0x36, 0xb6, 0x61, 0xe5, 0x3e, 0x0b, 0xc5, 0x17, 0x11,
+	0x09, 0x0b, 0x6f, 0xc0, 0x8c, 0x1e, 0x2c, 0xc7, 0x73, 0x64, 0xd3, 0x2a, 0xc4, 0x2d, 0x00, 0x21,
+	0x72, 0x6d, 0xc9, 0x6c, 0x7c, 0x5d, 0x80, 0x4a, 0xdf, 0xf7, 0x90, 0x37, 0x37, 0x97, 0xbd, 0x3a,
+	0xd4, 0xac, 0x06, 0x72, 0x31, 0x13, 0xc8, 0xb9, 0x80, 0xb1, 0x74, 0x63, 0xc0, 0x58, 0xce, 0x01,
+	0x8c, 0xaf, 0x0a, 0x29, 0x1b, 0x7f, 0xd3, 0x80, 0x88, 0x5a, 0x23, 0xcc, 0xb4, 0xb0, 0xd1, 0x68,
+	0x41, 0x65, 0x2c, 0x58, 0xa3, 0xb4, 0x2d, 0x87, 0x59, 0xf8, 0xaf, 0xbf, 0x3c, 0xfc, 0x4f, 0xc1,
+	0xf6, 0xe2, 0x7c, 0xd8, 0x5e, 0x7a, 0xd9, 0x36, 0xee, 0x37, 0x1a, 0xdc, 0x52, 0x77, 0x1a, 0x05,
+	0xe3, 0x53, 0x20, 0xc1, 0x35, 0x03, 0xc8, 0x30, 0xdb, 0x98, 0x59, 0x99, 0x55, 0xe6, 0x83, 0x25,
+	0x9a, 0x23, 0xe2, 0x26, 0x70, 0xfb, 0x08, 0xd6, 0x77, 0x79, 0x3d, 0xca, 0xe8, 0xf4, 0x61, 0x62,
+	0x64, 0xa1, 0x48, 0x3b, 0xf7, 0x92, 0x46, 0xcc, 0x89, 0x58, 0x8d, 0x75, 0x20, 0x19, 0x69, 0x58,
+	0x63, 0x8e, 0x60, 0xfd, 0xf1, 0xd8, 0x52, 0xa9, 0xff, 0xe5, 0x1a, 0x19, 0x69, 0xb8, 0xc6, 0x3b,
+	0xb0, 0x76, 0x9f, 0x85, 0x99, 0x05, 0xf2, 0x50, 0xd1, 0x7d, 0x58, 0x55, 0x19, 0xf1, 0x68, 0x7f,
+	0x3b, 0x3d, 0x5e, 0x83, 0x5b, 0x98, 0x9f, 0x24, 0x3d, 0x4e, 0x5b, 0x0f, 0x60, 0x2d, 0x4d, 0xc6,
+	0x15, 0x3e, 0x86, 0xaa, 0x9c, 0x16, 0xa5, 0xae, 0x79, 0x4b, 0xc4, 0xbc, 0xc6, 0x3d, 0x58, 0xdf,
+	0x63, 0x0e, 0x0b, 0xd9, 0x0d, 0x36, 0xb6, 0x0e, 0x24, 0xc3, 0x8b, 0x76, 0xf9, 0x9d, 0x06, 0x95,
+	0xa7, 0xec, 0xec, 0xc2, 0xf3, 0x2e, 0xf9, 0xf5, 0x6e, 0x94, 0xeb, 0x0a, 0x02, 0x80, 0x4e, 0x7c,
+	0x27, 0x82, 0xa4, 0x13, 0xdf, 0x49, 0x77, 0x06, 0x7a, 0xb6, 0x33, 0x50, 0x8e, 0x64, 0x31, 0x7d,
+	0x24, 0xb1, 0x0b, 0x60, 0x03, 0x9f, 0x85, 0xb2, 0x09, 0x91, 0xa3, 0x34, 0xfc, 0x29, 0x67, 0xe1,
+	0xcf, 0xbf, 0x35, 0x7c, 0xc6, 0x71, 0xec, 0x2b, 0x7c, 0x3a, 0xcc, 0x2a, 0x77, 0x07, 0x6a, 0xcf,
+	0x85, 0xde, 0xf2, 0xd9, 0xa6, 0x46, 0x13, 0x42, 0x7c, 0xf7, 0xaf, 0xdf, 0xf4, 0xee, 0xbf, 0x0d,
+	0x55, 0x33, 0xba, 0xc6, 0x13, 0x05, 0x38, 0x1e, 0x63, 0x31, 0x70, 0xcc, 0x20, 0x94, 0x77, 0x7a,
+	0x12, 0xa6, 0xa9, 0x24, 0x54, 0xc6, 0x12, 0x8a, 0xc6, 0xef, 0x5a, 0x09, 0x01, 0x6b, 0x2e, 0x76,
+	0x3d, 0x93, 0x60, 0xd7, 0xb3, 0x18, 0x4f, 0x83, 0x25, 0xaa, 0x50, 0xb0, 0x58, 0x32, 0xdf, 0xf7,
+	0xc4, 0xd3, 0x61, 0x8d, 0x8a, 0x81, 0x71, 0x06, 0xb7, 0x29, 0x3b, 0xb7, 0x83, 0x90, 0xf9, 0xd2,
+	0x41, 0x0a, 0x00, 0x43, 0xbf, 0x68, 0x33, 0xfc, 0x52, 0x98, 0xe3, 0x97, 0xf4, 0x93, 0x0c, 0x9e,
+	0xbc, 0x6b, 0x6b, 0xc8, 0x88, 0x97, 0xb6, 0x9c, 0x13, 0xf1, 0xd1, 0x8c, 0x88, 0x35, 0x8a, 0x78,
+	0x49, 0xcf, 0x46, 0x7c, 0x42, 0x96, 0x11, 0x2f, 0xa7, 0xcd, 0x8b, 0xf8, 0x68, 0x89, 0x98, 0xd7,
+	0xb8, 0x07, 0xad, 0xc7, 0xae, 0x9f, 0x6f, 0x97, 0x4c, 0x88, 0x18, 0x2d, 0xb8, 0x9d, 0xc3, 0x8b,
+	0x51, 0xff, 0x11, 0xbc, 0x26, 0xb0, 0x03, 0x77, 0x91, 0x1d, 0x9f, 0xce, 0x74, 0x54, 0x69, 0x99,
+	0xa8, 0x32, 0x28, 0xdc, 0xca, 0x4e, 0xc3, 0xbd, 0x7c, 0x0f, 0xc0, 0x8a, 0x49, 0x73, 0x5b, 0x54,
+	0x11, 0xcb, 0x54, 0x61, 0xbf, 0xf7, 0x3e, 0xd4, 0xe2, 0xf7, 0x5d, 0x52, 0x83, 0x52, 0x9f, 0x1e,
+	0xee, 0xf6, 0x9a, 0x4b, 0xfc, 0xe7, 0xc9, 0xd3, 0x1e, 0x6d, 0x6a, 0xa4, 0x01, 0xb5, 0x7d, 0xda,
+	0x3b, 0x3d, 0x38, 0xee, 0x9d, 0x9e, 0x36, 0x0b, 0xf7, 0x7a, 0xd0, 0x48, 0xbd, 0x35, 0x90, 0x0a,
+	0xe8, 0xc7, 0xbd, 0xa7, 0xcd, 0x25, 0xb2, 0x06, 0x0d, 0x3e, 0xfd, 0xd9, 0xee, 0x41, 0xf7, 0xf8,
+	0x7e, 0x6f, 0xaf, 0xa9, 0x91, 0x3a, 0x54, 0x7a, 0x5f, 0xf4, 0x0f, 0x69, 0x6f, 0xaf, 0x59, 0xc0,
+	0x01, 0xed, 0x3d, 0x3c, 0x79, 0xd2, 0xdb, 0x6b, 0xea, 0xf7, 0xce, 0xa0, 0x91, 0xba, 0xd9, 0xc0,
+	0xaf, 0x8f, 0x8f, 0x1f, 0x1c, 0x9f, 0x3c, 0x3d, 0x6e, 0x2e, 0x91, 0x5b, 0xb0, 0x7a, 0x78, 0xfc,
+	0xa4, 0x7b, 0x74, 0xb8, 0xf7, 0xac, 0xbb, 0xb7, 0x47, 0x71, 0x65, 0x0d, 0x89, 0xdd, 0xd3, 0x07,
+	0xcf, 0x1e, 0x1f, 0x77, 0x9f, 0x74, 0x0f, 0x8f, 0xba, 0x3b, 0x47, 0xbd, 0x66, 0x01, 0xb5, 0xa3,
+	0xfd, 0xdd, 0x67, 0x3d, 0x4a, 0x4f, 0x68, 0x53, 0x47, 0x29, 0x8f, 0x0e, 0x1f, 0xf6, 0x4e, 0x1e,
+	0x3f, 0x6a, 0x16, 0xb7, 0xff, 0xd2, 0x04, 0xbd, 0xdb, 0x3f, 0x24, 0x26, 0x34, 0x52, 0x0f, 0x38,
+	0xe4, 0x9d, 0xbc, 0x07, 0x94, 0x9c, 0xa7, 0xa2, 0xf6, 0xc6, 0x62, 0x46, 0x74, 0xe8, 0x12, 0xf9,
+	0x02, 0x20, 0x79, 0x47, 0x20, 0x77, 0xf3, 0x1f, 0x68, 0xd2, 0x8f, 0x27, 0x6d, 0x63, 0x01, 0x97,
+	0x90, 0x4c, 0xa1, 0x16, 0x3f, 0x1a, 0x91, 0xb7, 0x66, 0x75, 0xff, 0xaa, 0xd2, 0x77, 0xe6, 0x3d,
+	0x0f, 0x19, 0x4b, 0xef, 0x6b, 0xe4, 0x01, 0x94, 0x78, 0x95, 0x26, 0x6f, 0xcc, 0x6e, 0xc5, 0x84,
+	0xac, 0xf9, 0xbd, 0x9a, 0xb1, 0xb4, 0xa9, 0x91, 0x53, 0xa8, 0xc5, 0x77, 0x6b, 0xb9, 0x0a, 0x66,
+	0xef, 0xed, 0x6e, 0x22, 0xf4, 0x01, 0x94, 0xf8, 0xbe, 0xc8, 0xcc, 0xfb, 0x8e, 0x79, 0xc2, 0x92,
+	0x9b, 0x17, 0xbe, 0x5d, 0x0a, 0xd5, 0xa8, 0x3f, 0x20, 0x79, 0x46, 0xcf, 0x34, 0x62, 0xed, 0xce,
+	0x5c, 0x1e, 0xe1, 0x96, 0x47, 0x50, 0x8d, 0x7a, 0xc6, 0x5c, 0x99, 0x99, 0xab, 0x97, 0x76, 0x67,
+	0x2e, 0x4f, 0xa4, 0xe9, 0x63, 0xa8, 0xc5, 0x5d, 0x45, 0xae, 0x2d, 0xb3, 0x7d, 0x48, 0xfb, 0xcd,
+	0xf9, 0x4c, 0x42, 0xd9, 0x13, 0xa8, 0xc8, 0x6e, 0x81, 0xe4, 0xf1, 0xa7, 0xbb, 0x8f, 0xf6, 0x1b,
+	0xf3, 0x58, 0x84, 0xc0, 0x1f, 0xc9, 0x2b, 0xff, 0xa8, 0x77, 0x78, 0x7b, 0x01, 0x0e, 0x7c, 0x09,
+	0xcf, 0x9b, 0xd0, 0x48, 0x81, 0xb4, 0xdc, 0xc3, 0x9a, 0x07, 0x0a, 0xdb, 0x1b, 0x8b, 0x19, 0x85,
+	0xf6, 0x26, 0x34, 0x52, 0x18, 0x2d, 0x77, 0x89, 0x3c, 0x4c, 0xd8, 0xde, 0x58, 0xcc, 0x18, 0xe7,
+	0x83, 0x04, 0xc7, 0xe5, 0xe6, 0x83, 0x6b, 0x78, 0xb0, 0x6d, 0x2c, 0xe0, 0x12, 0x92, 0xbf, 0x84,
+	0x65, 0x15, 0xc1, 0xe5, 0x9a, 0x3e, 0x07, 0xf9, 0xb5, 0xef, 0x2e, 0xe4, 0x8b, 0x8d, 0x93, 0x02,
+	0x6a, 0xb9, 0xc6, 0xc9, 0x83, 0x7d, 0xed, 0x8d, 0xc5, 0x8c, 0x62, 0x89, 0x73, 0xbc, 0x99, 0x4c,
+	0xd5, 0x45, 0xf2, 0x6e, 0xee, 0xf1, 0xc8, 0xab, 0xb3, 0xed, 0x77, 0x6e, 0xc2, 0x9a, 0xb2, 0x95,
+	0xa4, 0xce, 0xb6, 0x55, 0x06, 0x33, 0xb4, 0xef, 0x2e, 0xe4, 0x13, 0xf2, 0x47, 0xb0, 0x76, 0xad,
+	0xc4, 0x93, 0xff, 0xcb, 0x8b, 0x91, 0x19, 0xa0, 0xa1, 0xfd, 0xee, 0xcd, 0x98, 0xc5, 0x72, 0x56,
+	0x74, 0xe7, 0x10, 0x95, 0x6f, 0xb2, 0x39, 0xf3, 0xf4, 0x67, 0xa0, 0x45, 0xfb, 0xed, 0x1b, 0x70,
+	0x8a, 0x55, 0x7e, 0x2c, 0xdf, 0x0f, 0xc4, 0xbd, 0xe4, 0xdd, 0x59, 0x27, 0x56, 0xbd, 0x94, 0x6b,
+	0x1b, 0x0b, 0xb8, 0xa2, 0xc3, 0x4d, 0xa1, 0x1a, 0x5d, 0xd5, 0x91, 0x19, 0xe1, 0x9e, 0x92, 0xdb,
+	0x99, 0xcb, 0x23, 0xf4, 0xfd, 0x09, 0x2c, 0xab, 0xb7, 0x77, 0xb9, 0x4e, 0xce, 0xb9, 0xde, 0xbb,
+	0x59, 0x46, 0xde, 0xf9, 0x08, 0xee, 0xd8, 0xde, 0x56, 0xc8, 0x5e, 0x84, 0xb6, 0xc3, 0xae, 0xcf,
+	0xd8, 0x69, 0xec, 0x4b, 0x12, 0x4f, 0xb8, 0x7d, 0xed, 0x0f, 0x05, 0xfd, 0xd1, 0xa3, 0xde, 0x59,
+	0x99, 0xff, 0x1d, 0xf1, 0x83, 0xff, 0x0c, 0x00, 0xc7, 0xb3, 0x20, 0xc1, 0x9d, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	AvailableAsks(ctx context.Context, in *AvailableAsksRequest, opts ...grpc.CallOption) (*AvailableAsksReply, error)
+	AskHistory(ctx context.Context, in *AskHistoryRequest, opts ...grpc.CallOption) (*AskHistoryReply, error)
+	WatchAsks(ctx context.Context, in *WatchAsksRequest, opts ...grpc.CallOption) (API_WatchAsksClient, error)
+	Store(ctx context.Context, opts ...grpc.CallOption) (API_StoreClient, error)
+	StoreAuto(ctx context.Context, opts ...grpc.CallOption) (API_StoreAutoClient, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error)
+	Estimate(ctx context.Context, in *EstimateRequest, opts ...grpc.CallOption) (*EstimateReply, error)
+	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (API_RetrieveClient, error)
+	ListDeals(ctx context.Context, in *ListDealsRequest, opts ...grpc.CallOption) (*ListDealsReply, error)
+	GetDeal(ctx context.Context, in *GetDealRequest, opts ...grpc.CallOption) (*GetDealReply, error)
+	StoreProfile(ctx context.Context, opts ...grpc.CallOption) (API_StoreProfileClient, error)
+	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileReply, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error)
+	ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesReply, error)
+	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileReply, error)
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookReply, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error)
+	UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookReply, error)
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesReply, error)
+	StoreBatch(ctx context.Context, opts ...grpc.CallOption) (API_StoreBatchClient, error)
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchReply, error)
+	RetrieveFile(ctx context.Context, in *RetrieveFileRequest, opts ...grpc.CallOption) (API_RetrieveFileClient, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) AvailableAsks(ctx context.Context, in *AvailableAsksRequest, opts ...grpc.CallOption) (*AvailableAsksReply, error) {
+	out := new(AvailableAsksReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/AvailableAsks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AskHistory(ctx context.Context, in *AskHistoryRequest, opts ...grpc.CallOption) (*AskHistoryReply, error) {
+	out := new(AskHistoryReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/AskHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WatchAsks(ctx context.Context, in *WatchAsksRequest, opts ...grpc.CallOption) (API_WatchAsksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/filecoin.deals.pb.API/WatchAsks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWatchAsksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_WatchAsksClient interface {
+	Recv() (*AskChange, error)
+	grpc.ClientStream
+}
+
+type aPIWatchAsksClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIWatchAsksClient) Recv() (*AskChange, error) {
+	m := new(AskChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Store(ctx context.Context, opts ...grpc.CallOption) (API_StoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/filecoin.deals.pb.API/Store", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIStoreClient{stream}
+	return x, nil
+}
+
+type API_StoreClient interface {
+	Send(*StoreRequest) error
+	CloseAndRecv() (*StoreReply, error)
+	grpc.ClientStream
+}
+
+type aPIStoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIStoreClient) Send(m *StoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIStoreClient) CloseAndRecv() (*StoreReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StoreReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) StoreAuto(ctx context.Context, opts ...grpc.CallOption) (API_StoreAutoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/filecoin.deals.pb.API/StoreAuto", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIStoreAutoClient{stream}
+	return x, nil
+}
+
+type API_StoreAutoClient interface {
+	Send(*StoreAutoRequest) error
+	CloseAndRecv() (*StoreReply, error)
+	grpc.ClientStream
+}
+
+type aPIStoreAutoClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIStoreAutoClient) Send(m *StoreAutoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIStoreAutoClient) CloseAndRecv() (*StoreReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StoreReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/filecoin.deals.pb.API/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_WatchClient interface {
+	Recv() (*WatchReply, error)
+	grpc.ClientStream
+}
+
+type aPIWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIWatchClient) Recv() (*WatchReply, error) {
+	m := new(WatchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Estimate(ctx context.Context, in *EstimateRequest, opts ...grpc.CallOption) (*EstimateReply, error) {
+	out := new(EstimateReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/Estimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (API_RetrieveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/filecoin.deals.pb.API/Retrieve", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRetrieveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RetrieveClient interface {
+	Recv() (*RetrieveReply, error)
+	grpc.ClientStream
+}
+
+type aPIRetrieveClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRetrieveClient) Recv() (*RetrieveReply, error) {
+	m := new(RetrieveReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ListDeals(ctx context.Context, in *ListDealsRequest, opts ...grpc.CallOption) (*ListDealsReply, error) {
+	out := new(ListDealsReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/ListDeals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetDeal(ctx context.Context, in *GetDealRequest, opts ...grpc.CallOption) (*GetDealReply, error) {
+	out := new(GetDealReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/GetDeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) StoreProfile(ctx context.Context, opts ...grpc.CallOption) (API_StoreProfileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/filecoin.deals.pb.API/StoreProfile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIStoreProfileClient{stream}
+	return x, nil
+}
+
+type API_StoreProfileClient interface {
+	Send(*StoreProfileRequest) error
+	CloseAndRecv() (*StoreReply, error)
+	grpc.ClientStream
+}
+
+type aPIStoreProfileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIStoreProfileClient) Send(m *StoreProfileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIStoreProfileClient) CloseAndRecv() (*StoreReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StoreReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileReply, error) {
+	out := new(CreateProfileReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/CreateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error) {
+	out := new(UpdateProfileReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error) {
+	out := new(GetProfileReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesReply, error) {
+	out := new(ListProfilesReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileReply, error) {
+	out := new(DeleteProfileReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/DeleteProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookReply, error) {
+	out := new(RegisterWebhookReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/RegisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error) {
+	out := new(ListWebhooksReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookReply, error) {
+	out := new(UnregisterWebhookReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/UnregisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesReply, error) {
+	out := new(ListDeliveriesReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/ListDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) StoreBatch(ctx context.Context, opts ...grpc.CallOption) (API_StoreBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/filecoin.deals.pb.API/StoreBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIStoreBatchClient{stream}
+	return x, nil
+}
+
+type API_StoreBatchClient interface {
+	Send(*StoreBatchRequest) error
+	CloseAndRecv() (*StoreBatchReply, error)
+	grpc.ClientStream
+}
+
+type aPIStoreBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIStoreBatchClient) Send(m *StoreBatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIStoreBatchClient) CloseAndRecv() (*StoreBatchReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StoreBatchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchReply, error) {
+	out := new(GetBatchReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/GetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RetrieveFile(ctx context.Context, in *RetrieveFileRequest, opts ...grpc.CallOption) (API_RetrieveFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/filecoin.deals.pb.API/RetrieveFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRetrieveFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RetrieveFileClient interface {
+	Recv() (*RetrieveReply, error)
+	grpc.ClientStream
+}
+
+type aPIRetrieveFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRetrieveFileClient) Recv() (*RetrieveReply, error) {
+	m := new(RetrieveReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServer is the server API for API service.
+type APIServer interface {
+	AvailableAsks(context.Context, *AvailableAsksRequest) (*AvailableAsksReply, error)
+	AskHistory(context.Context, *AskHistoryRequest) (*AskHistoryReply, error)
+	WatchAsks(*WatchAsksRequest, API_WatchAsksServer) error
+	Store(API_StoreServer) error
+	StoreAuto(API_StoreAutoServer) error
+	Watch(*WatchRequest, API_WatchServer) error
+	Estimate(context.Context, *EstimateRequest) (*EstimateReply, error)
+	Retrieve(*RetrieveRequest, API_RetrieveServer) error
+	ListDeals(context.Context, *ListDealsRequest) (*ListDealsReply, error)
+	GetDeal(context.Context, *GetDealRequest) (*GetDealReply, error)
+	StoreProfile(API_StoreProfileServer) error
+	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileReply, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileReply, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
+	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesReply, error)
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileReply, error)
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
+	UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookReply, error)
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesReply, error)
+	StoreBatch(API_StoreBatchServer) error
+	GetBatch(context.Context, *GetBatchRequest) (*GetBatchReply, error)
+	RetrieveFile(*RetrieveFileRequest, API_RetrieveFileServer) error
+}
+
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (*UnimplementedAPIServer) AvailableAsks(ctx context.Context, req *AvailableAsksRequest) (*AvailableAsksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableAsks not implemented")
+}
+func (*UnimplementedAPIServer) AskHistory(ctx context.Context, req *AskHistoryRequest) (*AskHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AskHistory not implemented")
+}
+func (*UnimplementedAPIServer) WatchAsks(req *WatchAsksRequest, srv API_WatchAsksServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAsks not implemented")
+}
+func (*UnimplementedAPIServer) Store(srv API_StoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Store not implemented")
+}
+func (*UnimplementedAPIServer) StoreAuto(srv API_StoreAutoServer) error {
+	return status.Errorf(codes.Unimplemented, "method StoreAuto not implemented")
+}
+func (*UnimplementedAPIServer) Watch(req *WatchRequest, srv API_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedAPIServer) Estimate(ctx context.Context, req *EstimateRequest) (*EstimateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Estimate not implemented")
+}
+func (*UnimplementedAPIServer) Retrieve(req *RetrieveRequest, srv API_RetrieveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
+}
+func (*UnimplementedAPIServer) ListDeals(ctx context.Context, req *ListDealsRequest) (*ListDealsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeals not implemented")
+}
+func (*UnimplementedAPIServer) GetDeal(ctx context.Context, req *GetDealRequest) (*GetDealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeal not implemented")
+}
+func (*UnimplementedAPIServer) StoreProfile(srv API_StoreProfileServer) error {
+	return status.Errorf(codes.Unimplemented, "method StoreProfile not implemented")
+}
+func (*UnimplementedAPIServer) CreateProfile(ctx context.Context, req *CreateProfileRequest) (*CreateProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (*UnimplementedAPIServer) UpdateProfile(ctx context.Context, req *UpdateProfileRequest) (*UpdateProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedAPIServer) GetProfile(ctx context.Context, req *GetProfileRequest) (*GetProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (*UnimplementedAPIServer) ListProfiles(ctx context.Context, req *ListProfilesRequest) (*ListProfilesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (*UnimplementedAPIServer) DeleteProfile(ctx context.Context, req *DeleteProfileRequest) (*DeleteProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (*UnimplementedAPIServer) RegisterWebhook(ctx context.Context, req *RegisterWebhookRequest) (*RegisterWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (*UnimplementedAPIServer) ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedAPIServer) UnregisterWebhook(ctx context.Context, req *UnregisterWebhookRequest) (*UnregisterWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterWebhook not implemented")
+}
+func (*UnimplementedAPIServer) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (*ListDeliveriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (*UnimplementedAPIServer) StoreBatch(srv API_StoreBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method StoreBatch not implemented")
+}
+func (*UnimplementedAPIServer) GetBatch(ctx context.Context, req *GetBatchRequest) (*GetBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
+}
+func (*UnimplementedAPIServer) RetrieveFile(req *RetrieveFileRequest, srv API_RetrieveFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method RetrieveFile not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_AvailableAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailableAsksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AvailableAsks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/AvailableAsks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AvailableAsks(ctx, req.(*AvailableAsksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/AskHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AskHistory(ctx, req.(*AskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WatchAsks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAsksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).WatchAsks(m, &aPIWatchAsksServer{stream})
+}
+
+type API_WatchAsksServer interface {
+	Send(*AskChange) error
+	grpc.ServerStream
+}
+
+type aPIWatchAsksServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWatchAsksServer) Send(m *AskChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Store_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).Store(&aPIStoreServer{stream})
+}
+
+type API_StoreServer interface {
+	SendAndClose(*StoreReply) error
+	Recv() (*StoreRequest, error)
+	grpc.ServerStream
+}
+
+type aPIStoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIStoreServer) SendAndClose(m *StoreReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIStoreServer) Recv() (*StoreRequest, error) {
+	m := new(StoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_StoreAuto_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).StoreAuto(&aPIStoreAutoServer{stream})
+}
+
+type API_StoreAutoServer interface {
+	SendAndClose(*StoreReply) error
+	Recv() (*StoreAutoRequest, error)
+	grpc.ServerStream
+}
+
+type aPIStoreAutoServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIStoreAutoServer) SendAndClose(m *StoreReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIStoreAutoServer) Recv() (*StoreAutoRequest, error) {
+	m := new(StoreAutoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Watch(m, &aPIWatchServer{stream})
+}
+
+type API_WatchServer interface {
+	Send(*WatchReply) error
+	grpc.ServerStream
+}
+
+type aPIWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWatchServer) Send(m *WatchReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Estimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Estimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/Estimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Estimate(ctx, req.(*EstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Retrieve_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Retrieve(m, &aPIRetrieveServer{stream})
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+type API_RetrieveServer interface {
+	Send(*RetrieveReply) error
+	grpc.ServerStream
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+type aPIRetrieveServer struct {
+	grpc.ServerStream
 }
 
-func (c *aPIClient) AvailableAsks(ctx context.Context, in *AvailableAsksRequest, opts ...grpc.CallOption) (*AvailableAsksReply, error) {
-	out := new(AvailableAsksReply)
-	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/AvailableAsks", in, out, opts...)
-	if err != nil {
+func (x *aPIRetrieveServer) Send(m *RetrieveReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ListDeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDealsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(APIServer).ListDeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/ListDeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListDeals(ctx, req.(*ListDealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *aPIClient) Store(ctx context.Context, opts ...grpc.CallOption) (API_StoreClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/filecoin.deals.pb.API/Store", opts...)
-	if err != nil {
+func _API_GetDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDealRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &aPIStoreClient{stream}
-	return x, nil
+	if interceptor == nil {
+		return srv.(APIServer).GetDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/GetDeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDeal(ctx, req.(*GetDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_StoreClient interface {
-	Send(*StoreRequest) error
-	CloseAndRecv() (*StoreReply, error)
-	grpc.ClientStream
+func _API_StoreProfile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).StoreProfile(&aPIStoreProfileServer{stream})
 }
 
-type aPIStoreClient struct {
-	grpc.ClientStream
+type API_StoreProfileServer interface {
+	SendAndClose(*StoreReply) error
+	Recv() (*StoreProfileRequest, error)
+	grpc.ServerStream
 }
 
-func (x *aPIStoreClient) Send(m *StoreRequest) error {
-	return x.ClientStream.SendMsg(m)
+type aPIStoreProfileServer struct {
+	grpc.ServerStream
 }
 
-func (x *aPIStoreClient) CloseAndRecv() (*StoreReply, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(StoreReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (x *aPIStoreProfileServer) SendAndClose(m *StoreReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIStoreProfileServer) Recv() (*StoreProfileRequest, error) {
+	m := new(StoreProfileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/filecoin.deals.pb.API/Watch", opts...)
-	if err != nil {
+func _API_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &aPIWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(APIServer).CreateProfile(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/CreateProfile",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateProfile(ctx, req.(*CreateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_WatchClient interface {
-	Recv() (*WatchReply, error)
-	grpc.ClientStream
+func _API_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIWatchClient struct {
-	grpc.ClientStream
+func _API_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIWatchClient) Recv() (*WatchReply, error) {
-	m := new(WatchReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _API_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(APIServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/ListProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListProfiles(ctx, req.(*ListProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	AvailableAsks(context.Context, *AvailableAsksRequest) (*AvailableAsksReply, error)
-	Store(API_StoreServer) error
-	Watch(*WatchRequest, API_WatchServer) error
+func _API_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/DeleteProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+func _API_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAPIServer) AvailableAsks(ctx context.Context, req *AvailableAsksRequest) (*AvailableAsksReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AvailableAsks not implemented")
-}
-func (*UnimplementedAPIServer) Store(srv API_StoreServer) error {
-	return status.Errorf(codes.Unimplemented, "method Store not implemented")
-}
-func (*UnimplementedAPIServer) Watch(req *WatchRequest, srv API_WatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+func _API_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+func _API_UnregisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnregisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/UnregisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnregisterWebhook(ctx, req.(*UnregisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_AvailableAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AvailableAsksRequest)
+func _API_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).AvailableAsks(ctx, in)
+		return srv.(APIServer).ListDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/filecoin.deals.pb.API/AvailableAsks",
+		FullMethod: "/filecoin.deals.pb.API/ListDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).AvailableAsks(ctx, req.(*AvailableAsksRequest))
+		return srv.(APIServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Store_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).Store(&aPIStoreServer{stream})
+func _API_StoreBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).StoreBatch(&aPIStoreBatchServer{stream})
 }
 
-type API_StoreServer interface {
-	SendAndClose(*StoreReply) error
-	Recv() (*StoreRequest, error)
+type API_StoreBatchServer interface {
+	SendAndClose(*StoreBatchReply) error
+	Recv() (*StoreBatchRequest, error)
 	grpc.ServerStream
 }
 
-type aPIStoreServer struct {
+type aPIStoreBatchServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIStoreServer) SendAndClose(m *StoreReply) error {
+func (x *aPIStoreBatchServer) SendAndClose(m *StoreBatchReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *aPIStoreServer) Recv() (*StoreRequest, error) {
-	m := new(StoreRequest)
+func (x *aPIStoreBatchServer) Recv() (*StoreBatchRequest, error) {
+	m := new(StoreBatchRequest)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _API_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchRequest)
+func _API_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/GetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBatch(ctx, req.(*GetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RetrieveFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveFileRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(APIServer).Watch(m, &aPIWatchServer{stream})
+	return srv.(APIServer).RetrieveFile(m, &aPIRetrieveFileServer{stream})
 }
 
-type API_WatchServer interface {
-	Send(*WatchReply) error
+type API_RetrieveFileServer interface {
+	Send(*RetrieveReply) error
 	grpc.ServerStream
 }
 
-type aPIWatchServer struct {
+type aPIRetrieveFileServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIWatchServer) Send(m *WatchReply) error {
+func (x *aPIRetrieveFileServer) Send(m *RetrieveReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -898,18 +5090,104 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AvailableAsks",
 			Handler:    _API_AvailableAsks_Handler,
 		},
+		{
+			MethodName: "AskHistory",
+			Handler:    _API_AskHistory_Handler,
+		},
+		{
+			MethodName: "Estimate",
+			Handler:    _API_Estimate_Handler,
+		},
+		{
+			MethodName: "ListDeals",
+			Handler:    _API_ListDeals_Handler,
+		},
+		{
+			MethodName: "GetDeal",
+			Handler:    _API_GetDeal_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _API_CreateProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _API_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _API_GetProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _API_ListProfiles_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _API_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _API_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _API_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UnregisterWebhook",
+			Handler:    _API_UnregisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _API_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "GetBatch",
+			Handler:    _API_GetBatch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAsks",
+			Handler:       _API_WatchAsks_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Store",
 			Handler:       _API_Store_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StoreAuto",
+			Handler:       _API_StoreAuto_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Watch",
 			Handler:       _API_Watch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Retrieve",
+			Handler:       _API_Retrieve_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StoreProfile",
+			Handler:       _API_StoreProfile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StoreBatch",
+			Handler:       _API_StoreBatch_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RetrieveFile",
+			Handler:       _API_RetrieveFile_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "deals.proto",
 }
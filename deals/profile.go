@@ -0,0 +1,115 @@
+package deals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+)
+
+var (
+	// ErrProfileExists returns when creating a profile with a name that's
+	// already in use
+	ErrProfileExists = errors.New("profile already exists")
+)
+
+// InvalidProfileError returns when creating or updating a Profile that can't be
+// used to store data
+type InvalidProfileError struct {
+	Reason string
+}
+
+func (e *InvalidProfileError) Error() string {
+	return e.Reason
+}
+
+// Profile is a named storage policy that can be reused in StoreProfile
+type Profile struct {
+	Name              string
+	ReplicationFactor int
+	MaxPrice          uint64
+	Duration          uint64
+	ExcludedCountries []string
+	MinReputation     int
+	RenewalPolicy     *RenewalPolicy
+}
+
+// CreateProfile persists a new Profile
+func (m *Module) CreateProfile(p Profile) error {
+	if err := validateProfile(p); err != nil {
+		return err
+	}
+	if _, err := m.store.getProfile(p.Name); err == nil {
+		return ErrProfileExists
+	} else if err != ErrProfileNotFound {
+		return fmt.Errorf("error when getting profile: %s", err)
+	}
+	return m.store.putProfile(p)
+}
+
+// UpdateProfile overwrites an existing Profile
+func (m *Module) UpdateProfile(p Profile) error {
+	if err := validateProfile(p); err != nil {
+		return err
+	}
+	if _, err := m.store.getProfile(p.Name); err != nil {
+		return err
+	}
+	return m.store.putProfile(p)
+}
+
+// GetProfile returns the Profile with the indicated name
+func (m *Module) GetProfile(name string) (Profile, error) {
+	return m.store.getProfile(name)
+}
+
+// ListProfiles returns all the persisted Profiles
+func (m *Module) ListProfiles() ([]Profile, error) {
+	return m.store.getAllProfiles()
+}
+
+// DeleteProfile removes the Profile with the indicated name
+func (m *Module) DeleteProfile(name string) error {
+	return m.store.deleteProfile(name)
+}
+
+// StoreProfile creates a proposal deal for data using wallet addr to the best
+// miners satisfying the Profile with the indicated name, for the duration and
+// with the renewal settings of the profile.
+func (m *Module) StoreProfile(ctx context.Context, addr string, data io.Reader, name string, opts ...StoreOption) ([]cid.Cid, []FailedDeal, error) {
+	p, err := m.store.getProfile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	config := AutoConfig{
+		ReplicationFactor: p.ReplicationFactor,
+		MaxPrice:          p.MaxPrice,
+		ExcludedCountries: p.ExcludedCountries,
+		MinReputation:     p.MinReputation,
+	}
+	if p.RenewalPolicy != nil {
+		opts = append(opts, WithRenewalPolicy(*p.RenewalPolicy))
+	}
+	return m.StoreAuto(ctx, addr, data, config, p.Duration, opts...)
+}
+
+// validateProfile checks that a Profile can be used to store data, returning an
+// InvalidProfileError otherwise
+func validateProfile(p Profile) error {
+	if p.Name == "" || strings.Contains(p.Name, "/") {
+		return &InvalidProfileError{Reason: fmt.Sprintf("profile name %q is invalid", p.Name)}
+	}
+	if p.ReplicationFactor <= 0 {
+		return &InvalidProfileError{Reason: "replication factor should be greater than zero"}
+	}
+	if p.Duration == 0 {
+		return &InvalidProfileError{Reason: "duration should be greater than zero"}
+	}
+	if p.MinReputation < 0 {
+		return &InvalidProfileError{Reason: "min reputation can't be negative"}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package deals
+
+import (
+	"testing"
+
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/index/miner"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestProfileCRUD(t *testing.T) {
+	t.Parallel()
+	m := &Module{store: newDealStore(tests.NewTxMapDatastore())}
+
+	p := Profile{Name: "archive", ReplicationFactor: 2, Duration: 1000, RenewalPolicy: &RenewalPolicy{Threshold: 10}}
+	checkErr(t, m.CreateProfile(p))
+	if err := m.CreateProfile(p); err != ErrProfileExists {
+		t.Fatalf("expected ErrProfileExists, got %v", err)
+	}
+	if _, ok := m.CreateProfile(Profile{Name: "invalid", Duration: 1000}).(*InvalidProfileError); !ok {
+		t.Fatalf("expected invalid profile error")
+	}
+
+	p.MaxPrice = 50
+	checkErr(t, m.UpdateProfile(p))
+	got, err := m.GetProfile("archive")
+	checkErr(t, err)
+	if got.MaxPrice != 50 || got.RenewalPolicy == nil || got.RenewalPolicy.Threshold != 10 {
+		t.Fatalf("unexpected profile %v", got)
+	}
+	if err := m.UpdateProfile(Profile{Name: "other", ReplicationFactor: 1, Duration: 1}); err != ErrProfileNotFound {
+		t.Fatalf("expected ErrProfileNotFound, got %v", err)
+	}
+
+	all, err := m.ListProfiles()
+	checkErr(t, err)
+	if len(all) != 1 {
+		t.Fatalf("expected 1 profile, got %d", len(all))
+	}
+	checkErr(t, m.DeleteProfile("archive"))
+	if err := m.DeleteProfile("archive"); err != ErrProfileNotFound {
+		t.Fatalf("expected ErrProfileNotFound, got %v", err)
+	}
+}
+
+func TestFilterAsksByCountry(t *testing.T) {
+	t.Parallel()
+	m := &Module{}
+	asks := []ask.StorageAsk{{Miner: "t01"}, {Miner: "t02"}, {Miner: "t03"}}
+	mi := miner.Index{
+		Meta: miner.MetaIndex{
+			Info: map[string]miner.Meta{
+				"t01": {Location: miner.Location{Country: "US"}},
+				"t02": {Location: miner.Location{Country: "CN"}},
+			},
+		},
+	}
+	filtered, err := m.filterAsks(asks, mi, AutoConfig{ExcludedCountries: []string{"cn"}})
+	checkErr(t, err)
+	if len(filtered) != 2 || filtered[0].Miner != "t01" || filtered[1].Miner != "t03" {
+		t.Fatalf("unexpected filtered asks %v", filtered)
+	}
+	if _, err := m.filterAsks(asks, mi, AutoConfig{MinReputation: 10}); err == nil {
+		t.Fatalf("expected error when reputation isn't available")
+	}
+}
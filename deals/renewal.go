@@ -0,0 +1,169 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// RenewalPolicy configures how deals created by Store are re-proposed before
+// they expire
+type RenewalPolicy struct {
+	// Threshold is the number of epochs before the expected end of a deal
+	// when it gets renewed
+	Threshold uint64
+	// MaxPrice is the maximum epoch price accepted for a renewal. If zero,
+	// any price is accepted.
+	MaxPrice uint64
+}
+
+// WithRenewalPolicy enables renewing active deals with the provided policy
+func WithRenewalPolicy(rp RenewalPolicy) StoreOption {
+	return func(c *StoreConfig) {
+		c.RenewalPolicy = &rp
+	}
+}
+
+// endEpoch returns the epoch when a deal is expected to end, or zero if it
+// isn't active yet
+func (dr DealRecord) endEpoch() uint64 {
+	if dr.ActivationEpoch == 0 {
+		return 0
+	}
+	return dr.ActivationEpoch + dr.Duration
+}
+
+// renewDeals re-proposes the data of active deals with a RenewalPolicy that
+// are about to expire. Deals becoming active get their activation epoch
+// recorded to know when they end.
+func (m *Module) renewDeals(ctx context.Context) {
+	records, err := m.store.getAll()
+	if err != nil {
+		log.Errorf("error when getting deal records: %s", err)
+		return
+	}
+	var renewable []DealRecord
+	for _, dr := range records {
+		if dr.RenewalPolicy != nil && dr.Info.StateID == types.DealComplete && !isRenewed(dr) {
+			renewable = append(renewable, dr)
+		}
+	}
+	if len(renewable) == 0 {
+		return
+	}
+	head, err := m.api.ChainHead(ctx)
+	if err != nil {
+		log.Errorf("error when getting chain head: %s", err)
+		return
+	}
+	for _, dr := range renewable {
+		if dr.ActivationEpoch == 0 {
+			dr.ActivationEpoch = head.Height
+			if err := m.store.put(dr); err != nil {
+				log.Errorf("error when saving deal activation %s: %s", dr.ProposalCid, err)
+			}
+			continue
+		}
+		if head.Height+dr.RenewalPolicy.Threshold < dr.endEpoch() {
+			continue
+		}
+		if err := m.renewDeal(ctx, dr, records); err != nil {
+			log.Errorf("error when renewing deal %s: %s", dr.ProposalCid, err)
+		}
+	}
+}
+
+// renewDeal re-proposes the data of an expiring deal, preferring the same
+// miner, and publishes the renewal to Watch subscribers of the deal.
+func (m *Module) renewDeal(ctx context.Context, expiring DealRecord, records []DealRecord) error {
+	candidates, err := m.renewalCandidates(expiring, records)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		return fmt.Errorf("no miner available to renew deal")
+	}
+
+	for _, dconfig := range candidates {
+		proposal, err := m.startDeal(ctx, expiring.DataCid, expiring.Addr, dconfig, expiring.Duration)
+		if err != nil {
+			log.Errorf("error when renewing deal %s with %s: %s", expiring.ProposalCid, dconfig.Miner, err)
+			continue
+		}
+		dr := DealRecord{
+			ProposalCid:   *proposal,
+			DataCid:       expiring.DataCid,
+			ManifestCid:   expiring.ManifestCid,
+			BatchCid:      expiring.BatchCid,
+			Addr:          expiring.Addr,
+			Miner:         dconfig.Miner,
+			EpochPrice:    dconfig.EpochPrice,
+			Duration:      expiring.Duration,
+			CreatedAt:     time.Now(),
+			RetryPolicy:   expiring.RetryPolicy,
+			RenewalPolicy: expiring.RenewalPolicy,
+			RenewalOf:     expiring.ProposalCid,
+			Encryption:    expiring.Encryption,
+			Info:          newDealInfo(*proposal, dconfig.Miner, dconfig.EpochPrice, expiring.Duration),
+		}
+		if err := m.store.put(dr); err != nil {
+			return err
+		}
+
+		expiring.RenewedBy = *proposal
+		expiring.Info.RenewedBy = *proposal
+		expiring.Info.UpdatedAt = time.Now()
+		if err := m.store.putTransition(expiring.Info); err != nil {
+			log.Errorf("error when saving deal transition %s: %s", expiring.ProposalCid, err)
+		}
+		if err := m.store.put(expiring); err != nil {
+			return err
+		}
+		log.Infof("deal %s renewed with miner %s as %s", expiring.ProposalCid, dconfig.Miner, *proposal)
+		m.tracker.publish(ctx, expiring.Info)
+		m.tracker.requestRefresh()
+		return nil
+	}
+	return fmt.Errorf("no miner accepted the renewal")
+}
+
+// renewalCandidates returns the DealConfigs to try for renewing a deal. The
+// same miner goes first if its ask satisfies the policy, followed by the
+// cheapest miner that isn't storing the data yet.
+func (m *Module) renewalCandidates(expiring DealRecord, records []DealRecord) ([]DealConfig, error) {
+	maxPrice := expiring.RenewalPolicy.MaxPrice
+	if m.askIndex == nil {
+		if maxPrice != 0 && expiring.EpochPrice.GreaterThan(types.NewInt(maxPrice)) {
+			return nil, nil
+		}
+		return []DealConfig{{Miner: expiring.Miner, EpochPrice: expiring.EpochPrice}}, nil
+	}
+
+	var candidates []DealConfig
+	if sa, ok := m.askIndex.Get().Storage[expiring.Miner]; ok && (maxPrice == 0 || sa.Price <= maxPrice) {
+		candidates = append(candidates, DealConfig{Miner: sa.Miner, EpochPrice: types.NewInt(sa.Price)})
+	}
+
+	involved := make(map[string]struct{})
+	for _, dr := range records {
+		if dr.DataCid.Equals(expiring.DataCid) && dr.Addr == expiring.Addr && !isFailed(dr.Info.StateID) {
+			involved[dr.Miner] = struct{}{}
+		}
+	}
+	asks, err := m.askIndex.Query(ask.Query{MaxPrice: maxPrice})
+	if err != nil {
+		return nil, err
+	}
+	if sa, ok := selectMiner(asks, involved); ok {
+		candidates = append(candidates, DealConfig{Miner: sa.Miner, EpochPrice: types.NewInt(sa.Price)})
+	}
+	return candidates, nil
+}
+
+// isRenewed returns true if the deal was replaced by a renewal
+func isRenewed(dr DealRecord) bool {
+	return dr.RenewedBy.Defined()
+}
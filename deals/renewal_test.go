@@ -0,0 +1,125 @@
+package deals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestRenewDeals(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store)}
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	checkErr(t, store.put(DealRecord{
+		ProposalCid:   proposal,
+		DataCid:       proposal,
+		BatchCid:      proposal,
+		Miner:         "t0100",
+		EpochPrice:    types.NewInt(10),
+		Duration:      100,
+		RenewalPolicy: &RenewalPolicy{Threshold: 10},
+		Info:          DealInfo{ProposalCid: proposal, StateID: types.DealComplete},
+	}))
+	sub := m.tracker.subscribe([]cid.Cid{proposal})
+	defer m.tracker.unsubscribe(sub)
+
+	m.renewDeals(ctx)
+	dr, err := store.get(proposal)
+	checkErr(t, err)
+	if dr.ActivationEpoch != 100 || dr.endEpoch() != 200 {
+		t.Fatalf("unexpected activation epoch %d", dr.ActivationEpoch)
+	}
+
+	api.setHeight(189)
+	m.renewDeals(ctx)
+	if len(api.started) != 0 {
+		t.Fatalf("deal renewed too early")
+	}
+
+	api.setHeight(190)
+	m.renewDeals(ctx)
+	if len(api.started) != 1 || api.started[0] != "t0100" {
+		t.Fatalf("expected renewal with same miner, got %v", api.started)
+	}
+	dr, err = store.get(proposal)
+	checkErr(t, err)
+	if !isRenewed(dr) {
+		t.Fatalf("deal wasn't marked as renewed")
+	}
+	renewal, err := store.get(dr.RenewedBy)
+	checkErr(t, err)
+	if renewal.RenewalOf != proposal || renewal.RenewalPolicy == nil || renewal.Miner != "t0100" || renewal.BatchCid != proposal {
+		t.Fatalf("unexpected renewal record %v", renewal)
+	}
+
+	select {
+	case di := <-sub.ch:
+		if di.RenewedBy != dr.RenewedBy {
+			t.Fatalf("unexpected renewal event %v", di)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("renewal wasn't published")
+	}
+
+	m.renewDeals(ctx)
+	if len(api.started) != 1 {
+		t.Fatalf("deal renewed twice")
+	}
+}
+
+func TestRenewRetriedDeal(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t, ask.StorageAsk{Miner: "t02", Price: 20})
+	defer ai.Close()
+	ctx := context.Background()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, askIndex: ai, tracker: newTracker(api, store)}
+	failed := DealRecord{
+		ProposalCid:   genTestCid(t, "failed"),
+		DataCid:       genTestCid(t, "data"),
+		Addr:          "t3addr",
+		Miner:         "t01",
+		EpochPrice:    types.NewInt(100),
+		Duration:      100,
+		RetryPolicy:   &RetryPolicy{MaxAttempts: 1, ReplicationFactor: 1},
+		RenewalPolicy: &RenewalPolicy{Threshold: 10},
+		Info:          DealInfo{StateID: types.DealFailed},
+	}
+	checkErr(t, store.put(failed))
+
+	m.retryFailedDeals(ctx)
+	if len(api.started) != 1 {
+		t.Fatalf("expected a retry, got %v", api.started)
+	}
+	records, err := store.getAll()
+	checkErr(t, err)
+	var retry DealRecord
+	for _, dr := range records {
+		if dr.RetryOf == failed.ProposalCid {
+			retry = dr
+		}
+	}
+	if retry.RenewalPolicy == nil {
+		t.Fatalf("retry record lost the renewal policy")
+	}
+	retry.Info.StateID = types.DealComplete
+	checkErr(t, store.put(retry))
+
+	m.renewDeals(ctx)
+	api.setHeight(190)
+	m.renewDeals(ctx)
+	if len(api.started) != 2 || api.started[1] != "t02" {
+		t.Fatalf("expected the retried deal to be renewed, got %v", api.started)
+	}
+}
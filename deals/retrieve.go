@@ -0,0 +1,149 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// Retrieve fetches the data identified by dataCid from a miner storing it,
+// paying with wallet addr. Miners with completed deals created by Store are
+// preferred over other miners offering the data. If dataCid is the cid of a
+// Manifest, its pieces are retrieved and reassembled. If a decryption key is
+// provided, the data is decrypted after being retrieved. The returned
+// ReadCloser must be closed to release the retrieved copy.
+func (m *Module) Retrieve(ctx context.Context, addr string, dataCid cid.Cid, opts ...RetrieveOption) (io.ReadCloser, error) {
+	f, err := m.retrieve(ctx, addr, dataCid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// retrieve fetches dataCid as Retrieve does into a temporary file
+func (m *Module) retrieve(ctx context.Context, addr string, dataCid cid.Cid, opts ...RetrieveOption) (*tmpFile, error) {
+	var config RetrieveConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	var f *tmpFile
+	mf, err := m.store.getManifest(dataCid)
+	if err == nil {
+		f, err = m.retrieveManifest(ctx, addr, mf)
+	} else if err == ErrManifestNotFound {
+		f, err = m.retrieveData(ctx, addr, dataCid)
+	} else {
+		err = fmt.Errorf("error when getting manifest: %s", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.Key != nil {
+		return m.decryptRetrieved(config.Key, f)
+	}
+	return f, nil
+}
+
+// retrieveData fetches dataCid from the best miner offering it
+func (m *Module) retrieveData(ctx context.Context, addr string, dataCid cid.Cid) (*tmpFile, error) {
+	offers, err := m.findOffers(ctx, dataCid)
+	if err != nil {
+		return nil, err
+	}
+	if len(offers) == 0 {
+		return nil, fmt.Errorf("no miner offers data %s", dataCid)
+	}
+
+	for _, o := range offers {
+		f, err := m.retrieveFrom(ctx, addr, o)
+		if err != nil {
+			log.Errorf("error when retrieving %s from %s: %s", dataCid, o.Miner, err)
+			continue
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("couldn't retrieve data %s from any miner", dataCid)
+}
+
+// findOffers returns valid retrieval offers of dataCid, where offers from
+// miners with completed deals for the data go first, and then by price.
+func (m *Module) findOffers(ctx context.Context, dataCid cid.Cid) ([]types.QueryOffer, error) {
+	records, err := m.store.getAll()
+	if err != nil {
+		return nil, fmt.Errorf("error when getting deal records: %s", err)
+	}
+	storing := make(map[string]struct{})
+	for _, dr := range records {
+		if dr.DataCid.Equals(dataCid) && dr.Info.StateID == types.DealComplete {
+			storing[dr.Miner] = struct{}{}
+		}
+	}
+
+	allOffers, err := m.api.ClientFindData(ctx, dataCid)
+	if err != nil {
+		return nil, fmt.Errorf("error when finding data offers: %s", err)
+	}
+	offers := make([]types.QueryOffer, 0, len(allOffers))
+	for _, o := range allOffers {
+		if o.Err != "" {
+			log.Debugf("ignoring offer from %s: %s", o.Miner, o.Err)
+			continue
+		}
+		offers = append(offers, o)
+	}
+	sort.SliceStable(offers, func(i, j int) bool {
+		_, si := storing[offers[i].Miner]
+		_, sj := storing[offers[j].Miner]
+		if si != sj {
+			return si
+		}
+		return offers[i].MinPrice.LessThan(offers[j].MinPrice)
+	})
+	return offers, nil
+}
+
+// retrieveFrom retrieves the data of an offer into a temporary file
+func (m *Module) retrieveFrom(ctx context.Context, addr string, o types.QueryOffer) (*tmpFile, error) {
+	path, err := m.staging.tempFile(retrievePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	order := types.RetrievalOrder{
+		Root:        o.Root,
+		Size:        o.Size,
+		Total:       o.MinPrice,
+		Client:      addr,
+		Miner:       o.Miner,
+		MinerPeerID: o.MinerPeerID,
+	}
+	if err := m.api.ClientRetrieve(ctx, order, path); err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("error when opening retrieved file: %s", err)
+	}
+	return &tmpFile{File: f}, nil
+}
+
+// tmpFile is a file that gets deleted when closed
+type tmpFile struct {
+	*os.File
+}
+
+// Close closes and deletes the file
+func (f *tmpFile) Close() error {
+	err := f.File.Close()
+	if rerr := os.Remove(f.Name()); rerr != nil && err == nil {
+		err = rerr
+	}
+	return err
+}
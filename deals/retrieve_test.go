@@ -0,0 +1,42 @@
+package deals
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestFindOffers(t *testing.T) {
+	t.Parallel()
+	dataCid, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	api := newMockAPI()
+	api.offers = []types.QueryOffer{
+		{Miner: "t01", MinPrice: types.NewInt(30)},
+		{Miner: "t02", MinPrice: types.NewInt(10)},
+		{Miner: "t03", MinPrice: types.NewInt(50)},
+		{Miner: "t04", MinPrice: types.NewInt(5), Err: "unavailable"},
+	}
+	m := &Module{api: api, store: newDealStore(tests.NewTxMapDatastore())}
+	checkErr(t, m.store.put(DealRecord{
+		ProposalCid: dataCid,
+		DataCid:     dataCid,
+		Miner:       "t03",
+		Info:        DealInfo{StateID: types.DealComplete},
+	}))
+
+	offers, err := m.findOffers(context.Background(), dataCid)
+	checkErr(t, err)
+	got := make([]string, len(offers))
+	for i, o := range offers {
+		got[i] = o.Miner
+	}
+	expect := []string{"t03", "t02", "t01"}
+	if !reflect.DeepEqual(expect, got) {
+		t.Fatalf("expected %v, got %v", expect, got)
+	}
+}
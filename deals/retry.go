@@ -0,0 +1,177 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+// RetryPolicy configures how failed deals created by Store are re-proposed to
+// other miners
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of re-proposals for each original deal
+	MaxAttempts int
+	// Backoff is the wait before the first re-proposal, which doubles on
+	// every following attempt
+	Backoff time.Duration
+	// PriceBump is the percentage increase of the epoch price on each
+	// re-proposal
+	PriceBump uint64
+	// ReplicationFactor is the number of active deals to reach for the data.
+	// If zero, it defaults to the number of DealConfigs used in Store.
+	ReplicationFactor int
+}
+
+// StoreConfig contains optional settings for Store
+type StoreConfig struct {
+	RetryPolicy   *RetryPolicy
+	RenewalPolicy *RenewalPolicy
+	ChunkSize     uint64
+	Encryption    *EncryptionKey
+}
+
+// StoreOption modifies a StoreConfig
+type StoreOption func(*StoreConfig)
+
+// WithRetryPolicy enables re-proposing failed deals with the provided policy
+func WithRetryPolicy(rp RetryPolicy) StoreOption {
+	return func(c *StoreConfig) {
+		c.RetryPolicy = &rp
+	}
+}
+
+// isFailed returns true if the deal ended without being stored
+func isFailed(state uint64) bool {
+	switch state {
+	case types.DealRejected, types.DealFailed, types.DealError:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryFailedDeals re-proposes the data of failed deals with a RetryPolicy to
+// the next cheapest miner that isn't already involved with the data, as long
+// as the replication factor isn't reached and attempts are left.
+func (m *Module) retryFailedDeals(ctx context.Context) {
+	if m.askIndex == nil {
+		return
+	}
+	records, err := m.store.getAll()
+	if err != nil {
+		log.Errorf("error when getting deal records: %s", err)
+		return
+	}
+	for _, dr := range records {
+		if dr.RetryPolicy == nil || dr.Retried || !isFailed(dr.Info.StateID) {
+			continue
+		}
+		backoff := dr.RetryPolicy.Backoff << uint(dr.Attempt)
+		if time.Since(dr.Info.UpdatedAt) < backoff {
+			continue
+		}
+		retry, err := m.retryDeal(ctx, dr, records)
+		if retry != nil {
+			// following retries of the same data take it into account
+			records = append(records, *retry)
+		}
+		if err != nil {
+			log.Errorf("error when retrying deal %s: %s", dr.ProposalCid, err)
+		}
+	}
+}
+
+// retryDeal re-proposes the data of a failed deal and marks it as retried,
+// returning the record of the new deal if one was proposed. The record is
+// returned even if marking the failed deal fails.
+func (m *Module) retryDeal(ctx context.Context, failed DealRecord, records []DealRecord) (*DealRecord, error) {
+	active := 0
+	involved := make(map[string]struct{})
+	for _, dr := range records {
+		if !dr.DataCid.Equals(failed.DataCid) || dr.Addr != failed.Addr {
+			continue
+		}
+		involved[dr.Miner] = struct{}{}
+		if !isFailed(dr.Info.StateID) && !isRenewed(dr) {
+			active++
+		}
+	}
+
+	if active >= failed.RetryPolicy.ReplicationFactor {
+		log.Infof("replication factor reached for %s, not retrying %s", failed.DataCid, failed.ProposalCid)
+		failed.Retried = true
+		return nil, m.store.put(failed)
+	}
+	if failed.Attempt >= failed.RetryPolicy.MaxAttempts {
+		log.Warnf("max attempts reached for deal %s", failed.ProposalCid)
+		failed.Retried = true
+		return nil, m.store.put(failed)
+	}
+
+	price := bumpPrice(failed.EpochPrice, failed.RetryPolicy.PriceBump)
+	asks, err := m.askIndex.Query(ask.Query{MaxPrice: price.Uint64()})
+	if err != nil {
+		return nil, err
+	}
+	sa, ok := selectMiner(asks, involved)
+	if !ok {
+		log.Warnf("no miner available to retry deal %s", failed.ProposalCid)
+		failed.Retried = true
+		return nil, m.store.put(failed)
+	}
+
+	proposal, err := m.startDeal(ctx, failed.DataCid, failed.Addr, DealConfig{Miner: sa.Miner, EpochPrice: price}, failed.Duration)
+	if err != nil {
+		// the failed attempt counts, so the deal isn't retried forever and
+		// the backoff grows from now
+		failed.Attempt++
+		failed.Info.UpdatedAt = time.Now()
+		if perr := m.store.put(failed); perr != nil {
+			return nil, fmt.Errorf("error when saving failed attempt: %s", perr)
+		}
+		return nil, fmt.Errorf("error when starting deal with miner %s: %s", sa.Miner, err)
+	}
+	dr := DealRecord{
+		ProposalCid:   *proposal,
+		DataCid:       failed.DataCid,
+		ManifestCid:   failed.ManifestCid,
+		BatchCid:      failed.BatchCid,
+		Addr:          failed.Addr,
+		Miner:         sa.Miner,
+		EpochPrice:    price,
+		Duration:      failed.Duration,
+		CreatedAt:     time.Now(),
+		RetryPolicy:   failed.RetryPolicy,
+		Attempt:       failed.Attempt + 1,
+		RetryOf:       failed.ProposalCid,
+		RenewalPolicy: failed.RenewalPolicy,
+		Encryption:    failed.Encryption,
+		Info:          newDealInfo(*proposal, sa.Miner, price, failed.Duration),
+	}
+	if err := m.store.put(dr); err != nil {
+		return nil, err
+	}
+	log.Infof("deal %s retried with miner %s as %s", failed.ProposalCid, sa.Miner, dr.ProposalCid)
+	failed.Retried = true
+	return &dr, m.store.put(failed)
+}
+
+// selectMiner returns the first ask of a miner that isn't excluded. asks are
+// expected to be ordered by price.
+func selectMiner(asks []ask.StorageAsk, exclude map[string]struct{}) (ask.StorageAsk, bool) {
+	for _, sa := range asks {
+		if _, ok := exclude[sa.Miner]; !ok {
+			return sa, true
+		}
+	}
+	return ask.StorageAsk{}, false
+}
+
+// bumpPrice returns price increased by percentage
+func bumpPrice(price types.BigInt, percentage uint64) types.BigInt {
+	bump := types.BigDiv(types.BigMul(price, types.NewInt(percentage)), types.NewInt(100))
+	return types.BigAdd(price, bump)
+}
@@ -0,0 +1,238 @@
+package deals
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/textileio/filecoin/index/ask"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestSelectMiner(t *testing.T) {
+	t.Parallel()
+	asks := []ask.StorageAsk{
+		{Miner: "t01", Price: 10},
+		{Miner: "t02", Price: 20},
+		{Miner: "t03", Price: 30},
+	}
+
+	tests := []struct {
+		name    string
+		exclude map[string]struct{}
+		expect  string
+		found   bool
+	}{
+		{name: "Cheapest", exclude: nil, expect: "t01", found: true},
+		{name: "SkipExcluded", exclude: map[string]struct{}{"t01": {}, "t02": {}}, expect: "t03", found: true},
+		{name: "AllExcluded", exclude: map[string]struct{}{"t01": {}, "t02": {}, "t03": {}}, found: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sa, ok := selectMiner(asks, tt.exclude)
+			if ok != tt.found || sa.Miner != tt.expect {
+				t.Fatalf("expected (%s, %v), got (%s, %v)", tt.expect, tt.found, sa.Miner, ok)
+			}
+		})
+	}
+}
+
+func TestBumpPrice(t *testing.T) {
+	t.Parallel()
+	got := bumpPrice(types.NewInt(200), 15)
+	if !got.Equals(types.NewInt(230)) {
+		t.Fatalf("expected 230, got %s", got)
+	}
+	got = bumpPrice(types.NewInt(200), 0)
+	if !got.Equals(types.NewInt(200)) {
+		t.Fatalf("expected 200, got %s", got)
+	}
+}
+
+func TestRetryFailedDeals(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t,
+		ask.StorageAsk{Miner: "t01", Price: 10},
+		ask.StorageAsk{Miner: "t02", Price: 20},
+		ask.StorageAsk{Miner: "t03", Price: 500},
+	)
+	defer ai.Close()
+	dataCid := genTestCid(t, "data")
+	policy := &RetryPolicy{MaxAttempts: 2, Backoff: time.Hour, PriceBump: 10, ReplicationFactor: 1}
+	failed := DealRecord{
+		ProposalCid: genTestCid(t, "failed"),
+		DataCid:     dataCid,
+		Addr:        "t3addr",
+		Miner:       "t01",
+		EpochPrice:  types.NewInt(100),
+		Duration:    100,
+		RetryPolicy: policy,
+		Info:        DealInfo{StateID: types.DealFailed, UpdatedAt: time.Now().Add(-time.Minute * 90)},
+	}
+
+	cases := []struct {
+		name    string
+		modify  func(dr *DealRecord)
+		others  []DealRecord
+		retried bool
+		miner   string
+	}{
+		{name: "SkipInvolved", retried: true, miner: "t02"},
+		{
+			name: "ReplicationFactorReached",
+			others: []DealRecord{{
+				ProposalCid: genTestCid(t, "active"),
+				DataCid:     dataCid,
+				Addr:        "t3addr",
+				Miner:       "t02",
+				Info:        DealInfo{StateID: types.DealComplete},
+			}},
+			retried: true,
+		},
+		{
+			name: "MaxAttempts",
+			modify: func(dr *DealRecord) {
+				dr.Attempt = 2
+				dr.Info.UpdatedAt = time.Now().Add(-time.Hour * 5)
+			},
+			retried: true,
+		},
+		{name: "Backoff", modify: func(dr *DealRecord) { dr.Info.UpdatedAt = time.Now() }, retried: false},
+		{name: "DoubledBackoff", modify: func(dr *DealRecord) { dr.Attempt = 1 }, retried: false},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := newMockAPI()
+			store := newDealStore(tests.NewTxMapDatastore())
+			m := &Module{api: api, store: store, askIndex: ai}
+			dr := failed
+			if tt.modify != nil {
+				tt.modify(&dr)
+			}
+			checkErr(t, store.put(dr))
+			for _, o := range tt.others {
+				checkErr(t, store.put(o))
+			}
+
+			m.retryFailedDeals(context.Background())
+			saved, err := store.get(dr.ProposalCid)
+			checkErr(t, err)
+			if saved.Retried != tt.retried {
+				t.Fatalf("expected retried %v, got %v", tt.retried, saved.Retried)
+			}
+			if tt.miner == "" {
+				if len(api.started) != 0 {
+					t.Fatalf("expected no new deals, got %v", api.started)
+				}
+				return
+			}
+			if len(api.started) != 1 || api.started[0] != tt.miner {
+				t.Fatalf("expected a deal with %s, got %v", tt.miner, api.started)
+			}
+			records, err := store.getAll()
+			checkErr(t, err)
+			var retry *DealRecord
+			for i := range records {
+				if records[i].RetryOf == dr.ProposalCid {
+					retry = &records[i]
+				}
+			}
+			if retry == nil {
+				t.Fatal("retry record wasn't saved")
+			}
+			if retry.Miner != tt.miner || !retry.EpochPrice.Equals(types.NewInt(110)) || retry.Attempt != dr.Attempt+1 {
+				t.Fatalf("unexpected retry record %v", retry)
+			}
+		})
+	}
+}
+
+func TestRetrySimultaneousFailures(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t,
+		ask.StorageAsk{Miner: "t03", Price: 10},
+		ask.StorageAsk{Miner: "t04", Price: 20},
+		ask.StorageAsk{Miner: "t05", Price: 30},
+	)
+	defer ai.Close()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, askIndex: ai}
+	dataCid := genTestCid(t, "data")
+	policy := &RetryPolicy{MaxAttempts: 1, ReplicationFactor: 2}
+	for _, miner := range []string{"t01", "t02"} {
+		checkErr(t, store.put(DealRecord{
+			ProposalCid: genTestCid(t, miner),
+			DataCid:     dataCid,
+			Addr:        "t3addr",
+			Miner:       miner,
+			EpochPrice:  types.NewInt(100),
+			RetryPolicy: policy,
+			Info:        DealInfo{StateID: types.DealFailed},
+		}))
+	}
+
+	m.retryFailedDeals(context.Background())
+	if len(api.started) != 2 || api.started[0] == api.started[1] {
+		t.Fatalf("expected retries with two different miners, got %v", api.started)
+	}
+	m.retryFailedDeals(context.Background())
+	if len(api.started) != 2 {
+		t.Fatalf("expected no more retries, got %v", api.started)
+	}
+}
+
+func TestRetryDealStartError(t *testing.T) {
+	t.Parallel()
+	ai := newTestAskIndex(t, ask.StorageAsk{Miner: "t02", Price: 20})
+	defer ai.Close()
+	api := newMockAPI()
+	api.startErr = fmt.Errorf("miner is offline")
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, askIndex: ai}
+	dr := DealRecord{
+		ProposalCid: genTestCid(t, "failed"),
+		DataCid:     genTestCid(t, "data"),
+		Addr:        "t3addr",
+		Miner:       "t01",
+		EpochPrice:  types.NewInt(100),
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1, Backoff: time.Hour, ReplicationFactor: 1},
+		Info:        DealInfo{StateID: types.DealFailed, UpdatedAt: time.Now().Add(-time.Hour * 2)},
+	}
+	checkErr(t, store.put(dr))
+
+	m.retryFailedDeals(context.Background())
+	saved, err := store.get(dr.ProposalCid)
+	checkErr(t, err)
+	if saved.Retried || saved.Attempt != 1 {
+		t.Fatalf("expected a counted attempt, got retried %v and attempt %d", saved.Retried, saved.Attempt)
+	}
+	if time.Since(saved.Info.UpdatedAt) > time.Minute {
+		t.Fatalf("backoff wasn't restarted")
+	}
+
+	// once the backoff passes, max attempts are reached
+	saved.Info.UpdatedAt = time.Now().Add(-time.Hour * 4)
+	checkErr(t, store.put(saved))
+	api.startErr = nil
+	m.retryFailedDeals(context.Background())
+	saved, err = store.get(dr.ProposalCid)
+	checkErr(t, err)
+	if !saved.Retried || len(api.started) != 0 {
+		t.Fatalf("expected deal to stop being retried, got %v and %v", saved.Retried, api.started)
+	}
+}
+
+func genTestCid(t *testing.T, s string) cid.Cid {
+	c, err := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(s))
+	checkErr(t, err)
+	return c
+}
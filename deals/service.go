@@ -3,6 +3,8 @@ package deals
 import (
 	"context"
 	"io"
+	"io/ioutil"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	pb "github.com/textileio/filecoin/deals/pb"
@@ -22,7 +24,7 @@ type Service struct {
 
 type storeResult struct {
 	Cids        []cid.Cid
-	FailedDeals []DealConfig
+	FailedDeals []FailedDeal
 	Err         error
 }
 
@@ -34,30 +36,299 @@ func NewService(dm *Module, ai *ask.AskIndex) *Service {
 	}
 }
 
-func store(ctx context.Context, dealsModule *Module, storeParams *pb.StoreParams, reader io.Reader, ch chan storeResult) {
+// store calls storeFunc with reader and sends the result to ch. The reader is
+// closed when storeFunc returns, so the writer doesn't block if it stopped
+// reading early, e.g. when the upload exceeds the staging limits.
+func store(reader *io.PipeReader, storeFunc func(io.Reader) ([]cid.Cid, []FailedDeal, error), ch chan storeResult) {
 	defer close(ch)
-	dealConfigs := make([]DealConfig, len(storeParams.GetDealConfigs()))
-	for i, dealConfig := range storeParams.GetDealConfigs() {
-		dealConfigs[i] = DealConfig{
-			Miner:      dealConfig.GetMiner(),
-			EpochPrice: types.NewInt(dealConfig.GetEpochPrice()),
-		}
-	}
-	cids, failedDeals, err := dealsModule.Store(ctx, storeParams.GetAddress(), reader, dealConfigs, storeParams.GetDuration())
+	cids, failedDeals, err := storeFunc(reader)
 	if err != nil {
+		_ = reader.CloseWithError(err)
 		ch <- storeResult{Err: err}
 		return
 	}
+	_ = reader.Close()
 	ch <- storeResult{Cids: cids, FailedDeals: failedDeals}
 }
 
+// receiveStore writes the chunks returned by recvChunk into storeFunc until it
+// returns io.EOF, and returns the result of storeFunc
+func receiveStore(recvChunk func() ([]byte, error), storeFunc func(io.Reader) ([]cid.Cid, []FailedDeal, error)) (storeResult, error) {
+	reader, writer := io.Pipe()
+	storeChannel := make(chan storeResult)
+	go store(reader, storeFunc, storeChannel)
+
+	var writeErr error
+	for {
+		chunk, err := recvChunk()
+		if err == io.EOF {
+			_ = writer.Close()
+			break
+		} else if err != nil {
+			_ = writer.CloseWithError(err)
+			<-storeChannel
+			return storeResult{}, err
+		}
+		if _, writeErr = writer.Write(chunk); writeErr != nil {
+			break
+		}
+	}
+
+	res := <-storeChannel
+	if res.Err != nil {
+		return storeResult{}, toStoreError(res.Err)
+	}
+	if writeErr != nil {
+		return storeResult{}, writeErr
+	}
+	return res, nil
+}
+
+func storeOptions(rp *pb.RetryPolicy, rnp *pb.RenewalPolicy, chunkSize uint64, enc *pb.Encryption) ([]StoreOption, *EncryptionKey) {
+	var opts []StoreOption
+	if rp != nil {
+		opts = append(opts, WithRetryPolicy(RetryPolicy{
+			MaxAttempts:       int(rp.GetMaxAttempts()),
+			Backoff:           time.Duration(rp.GetBackoff()) * time.Second,
+			PriceBump:         rp.GetPriceBump(),
+			ReplicationFactor: int(rp.GetReplicationFactor()),
+		}))
+	}
+	if rnp != nil {
+		opts = append(opts, WithRenewalPolicy(RenewalPolicy{
+			Threshold: rnp.GetThreshold(),
+			MaxPrice:  rnp.GetMaxPrice(),
+		}))
+	}
+	if chunkSize > 0 {
+		opts = append(opts, WithChunkSize(chunkSize))
+	}
+	var ek *EncryptionKey
+	if enc.GetEnabled() {
+		ek = &EncryptionKey{PublicKey: enc.GetPublicKey()}
+		opts = append(opts, WithEncryption(ek))
+	}
+	return opts, ek
+}
+
+func toStoreError(err error) error {
+	if err == ErrUploadTooLarge || err == ErrDiskQuotaExceeded {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if _, ok := err.(*InsufficientFundsError); ok {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err == ErrProfileNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// StoreBatch calls deals.StoreBatch
+func (s *Service) StoreBatch(srv pb.API_StoreBatchServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	var storeParams *pb.StoreParams
+	switch payload := req.GetPayload().(type) {
+	case *pb.StoreBatchRequest_StoreParams:
+		storeParams = payload.StoreParams
+	default:
+		return status.Errorf(codes.InvalidArgument, "expected StoreParams for StoreBatchRequest.Payload but got %T", payload)
+	}
+
+	dealConfigs, err := fromPbDealConfigs(storeParams.GetDealConfigs())
+	if err != nil {
+		return err
+	}
+	opts, ek := storeOptions(storeParams.GetRetryPolicy(), storeParams.GetRenewalPolicy(), storeParams.GetChunkSize(), storeParams.GetEncryption())
+	br := &batchStream{srv: srv}
+	b, proposals, failed, err := s.Module.StoreBatch(srv.Context(), storeParams.GetAddress(), br, dealConfigs, storeParams.GetDuration(), opts...)
+	if err != nil {
+		if br.err != nil {
+			return br.err
+		}
+		return toStoreError(err)
+	}
+	return srv.SendAndClose(&pb.StoreBatchReply{
+		Batch:      toPbBatch(b),
+		StoreReply: toStoreReply(storeResult{Cids: proposals, FailedDeals: failed}, ek),
+	})
+}
+
+// GetBatch calls deals.GetBatch
+func (s *Service) GetBatch(ctx context.Context, req *pb.GetBatchRequest) (*pb.GetBatchReply, error) {
+	c, err := cid.Decode(req.GetCid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid batch cid: %s", err)
+	}
+	b, err := s.Module.GetBatch(c)
+	if err == ErrBatchNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBatchReply{Batch: toPbBatch(b)}, nil
+}
+
+// RetrieveFile calls deals.RetrieveFile
+func (s *Service) RetrieveFile(req *pb.RetrieveFileRequest, srv pb.API_RetrieveFileServer) error {
+	batchCid, err := cid.Decode(req.GetBatchCid())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid batch cid: %s", err)
+	}
+	var opts []RetrieveOption
+	if len(req.GetKey()) > 0 {
+		opts = append(opts, WithDecryptionKey(req.GetKey()))
+	}
+	reader, err := s.Module.RetrieveFile(srv.Context(), req.GetAddress(), batchCid, req.GetName(), opts...)
+	if err == ErrBatchNotFound || err == ErrFileNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err == ErrDecryptionFailed {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return sendRetrieved(reader, srv.Send)
+}
+
+// batchStream is a BatchReader of the files sent in a StoreBatch stream, where
+// every file is a BatchFileHeader followed by its chunks
+type batchStream struct {
+	srv     pb.API_StoreBatchServer
+	next    *pb.BatchFileHeader
+	current *batchStreamFile
+	done    bool
+	err     error
+}
+
+// Next implements BatchReader
+func (bs *batchStream) Next() (BatchFile, error) {
+	if bs.current != nil {
+		// skip what wasn't read of the previous file
+		if _, err := io.Copy(ioutil.Discard, bs.current); err != nil {
+			return BatchFile{}, err
+		}
+		bs.current = nil
+	}
+	if bs.next == nil && !bs.done {
+		if _, err := bs.recv(); err != nil {
+			return BatchFile{}, err
+		}
+		if bs.next == nil && !bs.done {
+			bs.err = status.Error(codes.InvalidArgument, "expected File for StoreBatchRequest.Payload but got Chunk")
+			return BatchFile{}, bs.err
+		}
+	}
+	if bs.done && bs.next == nil {
+		return BatchFile{}, io.EOF
+	}
+	bs.current = &batchStreamFile{bs: bs}
+	name := bs.next.GetName()
+	bs.next = nil
+	return BatchFile{Name: name, Data: bs.current}, nil
+}
+
+// recv receives the next message of the stream, returning its chunk. A file
+// header is kept as next, and the end of the stream sets done.
+func (bs *batchStream) recv() ([]byte, error) {
+	req, err := bs.srv.Recv()
+	if err == io.EOF {
+		bs.done = true
+		return nil, nil
+	}
+	if err != nil {
+		bs.err = err
+		return nil, err
+	}
+	switch payload := req.GetPayload().(type) {
+	case *pb.StoreBatchRequest_File:
+		bs.next = payload.File
+		return nil, nil
+	case *pb.StoreBatchRequest_Chunk:
+		return payload.Chunk, nil
+	default:
+		bs.err = status.Errorf(codes.InvalidArgument, "expected File or Chunk for StoreBatchRequest.Payload but got %T", payload)
+		return nil, bs.err
+	}
+}
+
+// batchStreamFile reads the chunks of the current file of a batchStream
+type batchStreamFile struct {
+	bs  *batchStream
+	buf []byte
+	eof bool
+}
+
+func (f *batchStreamFile) Read(p []byte) (int, error) {
+	for len(f.buf) == 0 {
+		if f.eof {
+			return 0, io.EOF
+		}
+		chunk, err := f.bs.recv()
+		if err != nil {
+			return 0, err
+		}
+		if f.bs.next != nil || f.bs.done {
+			f.eof = true
+		}
+		f.buf = chunk
+	}
+	n := copy(p, f.buf)
+	f.buf = f.buf[n:]
+	return n, nil
+}
+
+func toStoreReply(res storeResult, ek *EncryptionKey) *pb.StoreReply {
+	replyCids := make([]string, len(res.Cids))
+	for i, cid := range res.Cids {
+		replyCids[i] = cid.String()
+	}
+
+	replyFailedDeals := make([]*pb.FailedDeal, len(res.FailedDeals))
+	for i, fd := range res.FailedDeals {
+		replyFailedDeals[i] = &pb.FailedDeal{
+			DealConfig: &pb.DealConfig{Miner: fd.Miner, EpochPrice: bigString(fd.EpochPrice)},
+			Reason:     pb.FailureReason(fd.Reason),
+			Message:    fd.Message,
+		}
+	}
+	reply := &pb.StoreReply{Cids: replyCids, FailedDeals: replyFailedDeals}
+	if ek != nil {
+		reply.EncryptionKey = ek.Key
+		reply.WrappedKey = ek.WrappedKey
+	}
+	return reply
+}
+
 // AvailableAsks calls deals.AvailableAsks
 func (s *Service) AvailableAsks(ctx context.Context, req *pb.AvailableAsksRequest) (*pb.AvailableAsksReply, error) {
+	if req.GetQuery().GetLimit() < 0 || req.GetQuery().GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset can't be negative")
+	}
+	sortBy := ask.SortOrder(req.GetQuery().GetSortBy())
+	switch sortBy {
+	case ask.SortByPrice, ask.SortByPower, ask.SortByFreshness:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort order %d", sortBy)
+	}
 	q := ask.Query{
-		MaxPrice:  req.GetQuery().GetMaxPrice(),
-		PieceSize: req.GetQuery().GetPieceSize(),
-		Limit:     int(req.GetQuery().GetLimit()),
-		Offset:    int(req.GetQuery().GetOffset()),
+		MaxPrice:       req.GetQuery().GetMaxPrice(),
+		PieceSize:      req.GetQuery().GetPieceSize(),
+		ExcludedMiners: req.GetQuery().GetExcludedMiners(),
+		IncludedMiners: req.GetQuery().GetIncludedMiners(),
+		NotExpired:     req.GetQuery().GetNotExpired(),
+		MinPower:       req.GetQuery().GetMinPower(),
+		Countries:      req.GetQuery().GetCountries(),
+		OnlyVerified:   req.GetQuery().GetOnlyVerified(),
+		SortBy:         sortBy,
+		Limit:          int(req.GetQuery().GetLimit()),
+		Offset:         int(req.GetQuery().GetOffset()),
 	}
 	asks, err := s.askIndex.Query(q)
 	if err != nil {
@@ -65,17 +336,70 @@ func (s *Service) AvailableAsks(ctx context.Context, req *pb.AvailableAsksReques
 	}
 	replyAsks := make([]*pb.StorageAsk, len(asks))
 	for i, ask := range asks {
-		replyAsks[i] = &pb.StorageAsk{
-			Price:        ask.Price,
-			MinPieceSize: ask.MinPieceSize,
-			Miner:        ask.Miner,
-			Timestamp:    ask.Timestamp,
-			Expiry:       ask.Expiry,
-		}
+		replyAsks[i] = toPbStorageAsk(ask)
 	}
 	return &pb.AvailableAsksReply{Asks: replyAsks}, nil
 }
 
+// WatchAsks streams the ask changes of miners, or of all miners if none is
+// specified
+func (s *Service) WatchAsks(req *pb.WatchAsksRequest, srv pb.API_WatchAsksServer) error {
+	miners := make(map[string]struct{}, len(req.GetMiners()))
+	for _, m := range req.GetMiners() {
+		miners[m] = struct{}{}
+	}
+	for changes := range s.askIndex.Changes(srv.Context()) {
+		for _, c := range changes {
+			if _, ok := miners[c.Ask.Miner]; len(miners) > 0 && !ok {
+				continue
+			}
+			reply := &pb.AskChange{
+				Type:     pb.AskChangeType(c.Type),
+				Ask:      toPbStorageAsk(c.Ask),
+				OldPrice: c.OldPrice,
+			}
+			if err := srv.Send(reply); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AskHistory calls ask.History
+func (s *Service) AskHistory(ctx context.Context, req *pb.AskHistoryRequest) (*pb.AskHistoryReply, error) {
+	var from, to time.Time
+	if req.GetFrom() != 0 {
+		from = time.Unix(0, req.GetFrom())
+	}
+	if req.GetTo() != 0 {
+		to = time.Unix(0, req.GetTo())
+	}
+	history, err := s.askIndex.History(req.GetMiner(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.AskHistoryReply{
+		Points:       make([]*pb.PricePoint, len(history.Points)),
+		DailyMedians: make([]*pb.DailyPrice, len(history.DailyMedians)),
+		Volatility:   history.Volatility,
+	}
+	for i, pp := range history.Points {
+		reply.Points[i] = &pb.PricePoint{
+			Miner: pp.Miner,
+			Price: pp.Price,
+			Time:  pp.Time.UnixNano(),
+		}
+	}
+	for i, dp := range history.DailyMedians {
+		reply.DailyMedians[i] = &pb.DailyPrice{
+			Day:    dp.Day.UnixNano(),
+			Median: dp.Median,
+		}
+	}
+	return reply, nil
+}
+
 // Store calls deals.Store
 func (s *Service) Store(srv pb.API_StoreServer) error {
 	req, err := srv.Recv()
@@ -90,47 +414,109 @@ func (s *Service) Store(srv pb.API_StoreServer) error {
 		return status.Errorf(codes.InvalidArgument, "expected StoreParams for StoreRequest.Payload but got %T", payload)
 	}
 
-	reader, writer := io.Pipe()
-
-	storeChannel := make(chan storeResult)
-	go store(srv.Context(), s.Module, storeParams, reader, storeChannel)
+	dealConfigs, err := fromPbDealConfigs(storeParams.GetDealConfigs())
+	if err != nil {
+		return err
+	}
+	opts, ek := storeOptions(storeParams.GetRetryPolicy(), storeParams.GetRenewalPolicy(), storeParams.GetChunkSize(), storeParams.GetEncryption())
+	storeFunc := func(r io.Reader) ([]cid.Cid, []FailedDeal, error) {
+		return s.Module.Store(srv.Context(), storeParams.GetAddress(), r, dealConfigs, storeParams.GetDuration(), opts...)
+	}
 
-	for {
+	res, err := receiveStore(func() ([]byte, error) {
 		req, err := srv.Recv()
-		if err == io.EOF {
-			_ = writer.Close()
-			break
-		} else if err != nil {
-			_ = writer.CloseWithError(err)
-			break
+		if err != nil {
+			return nil, err
 		}
-		switch payload := req.GetPayload().(type) {
-		case *pb.StoreRequest_Chunk:
-			_, writeErr := writer.Write(payload.Chunk)
-			if writeErr != nil {
-				return writeErr
-			}
-		default:
-			return status.Errorf(codes.InvalidArgument, "expected Chunk for StoreRequest.Payload but got %T", payload)
+		payload, ok := req.GetPayload().(*pb.StoreRequest_Chunk)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "expected Chunk for StoreRequest.Payload but got %T", req.GetPayload())
 		}
+		return payload.Chunk, nil
+	}, storeFunc)
+	if err != nil {
+		return err
 	}
+	return srv.SendAndClose(toStoreReply(res, ek))
+}
 
-	storeResult := <-storeChannel
-	if storeResult.Err != nil {
-		return storeResult.Err
+// StoreAuto calls deals.StoreAuto
+func (s *Service) StoreAuto(srv pb.API_StoreAutoServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	var storeAutoParams *pb.StoreAutoParams
+	switch payload := req.GetPayload().(type) {
+	case *pb.StoreAutoRequest_StoreAutoParams:
+		storeAutoParams = payload.StoreAutoParams
+	default:
+		return status.Errorf(codes.InvalidArgument, "expected StoreAutoParams for StoreAutoRequest.Payload but got %T", payload)
 	}
 
-	replyCids := make([]string, len(storeResult.Cids))
-	for i, cid := range storeResult.Cids {
-		replyCids[i] = cid.String()
+	config := AutoConfig{
+		ReplicationFactor: int(storeAutoParams.GetReplicationFactor()),
+		MaxPrice:          storeAutoParams.GetMaxPrice(),
+		PieceSize:         storeAutoParams.GetPieceSize(),
+		ExcludedCountries: storeAutoParams.GetExcludedCountries(),
+		MinReputation:     int(storeAutoParams.GetMinReputation()),
+	}
+	opts, ek := storeOptions(storeAutoParams.GetRetryPolicy(), storeAutoParams.GetRenewalPolicy(), storeAutoParams.GetChunkSize(), storeAutoParams.GetEncryption())
+	storeFunc := func(r io.Reader) ([]cid.Cid, []FailedDeal, error) {
+		return s.Module.StoreAuto(srv.Context(), storeAutoParams.GetAddress(), r, config, storeAutoParams.GetDuration(), opts...)
 	}
 
-	replyFailedDeals := make([]*pb.DealConfig, len(storeResult.FailedDeals))
-	for i, dealConfig := range storeResult.FailedDeals {
-		replyFailedDeals[i] = &pb.DealConfig{Miner: dealConfig.Miner, EpochPrice: dealConfig.EpochPrice.Uint64()}
+	res, err := receiveStore(func() ([]byte, error) {
+		req, err := srv.Recv()
+		if err != nil {
+			return nil, err
+		}
+		payload, ok := req.GetPayload().(*pb.StoreAutoRequest_Chunk)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "expected Chunk for StoreAutoRequest.Payload but got %T", req.GetPayload())
+		}
+		return payload.Chunk, nil
+	}, storeFunc)
+	if err != nil {
+		return err
 	}
+	return srv.SendAndClose(toStoreReply(res, ek))
+}
 
-	return srv.SendAndClose(&pb.StoreReply{Cids: replyCids, FailedDeals: replyFailedDeals})
+// StoreProfile calls deals.StoreProfile
+func (s *Service) StoreProfile(srv pb.API_StoreProfileServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	var storeProfileParams *pb.StoreProfileParams
+	switch payload := req.GetPayload().(type) {
+	case *pb.StoreProfileRequest_StoreProfileParams:
+		storeProfileParams = payload.StoreProfileParams
+	default:
+		return status.Errorf(codes.InvalidArgument, "expected StoreProfileParams for StoreProfileRequest.Payload but got %T", payload)
+	}
+
+	opts, ek := storeOptions(storeProfileParams.GetRetryPolicy(), nil, storeProfileParams.GetChunkSize(), storeProfileParams.GetEncryption())
+	storeFunc := func(r io.Reader) ([]cid.Cid, []FailedDeal, error) {
+		return s.Module.StoreProfile(srv.Context(), storeProfileParams.GetAddress(), r, storeProfileParams.GetProfile(), opts...)
+	}
+
+	res, err := receiveStore(func() ([]byte, error) {
+		req, err := srv.Recv()
+		if err != nil {
+			return nil, err
+		}
+		payload, ok := req.GetPayload().(*pb.StoreProfileRequest_Chunk)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "expected Chunk for StoreProfileRequest.Payload but got %T", req.GetPayload())
+		}
+		return payload.Chunk, nil
+	}, storeFunc)
+	if err != nil {
+		return err
+	}
+	return srv.SendAndClose(toStoreReply(res, ek))
 }
 
 // Watch calls deals.Watch
@@ -143,23 +529,422 @@ func (s *Service) Watch(req *pb.WatchRequest, srv pb.API_WatchServer) error {
 		}
 		proposals[i] = id
 	}
-	ch, err := s.Module.Watch(srv.Context(), proposals)
+	var since time.Time
+	if req.GetSince() != 0 {
+		since = time.Unix(0, req.GetSince())
+	}
+	opts := []WatchOption{WithMiners(req.GetMiners()...), WithStates(req.GetStates()...)}
+	if req.GetUntilDone() {
+		opts = append(opts, UntilDone())
+	}
+	ch, err := s.Module.Watch(srv.Context(), proposals, since, opts...)
 	if err != nil {
 		return err
 	}
 
 	for update := range ch {
-		dealInfo := &pb.DealInfo{
-			ProposalCid:   update.ProposalCid.String(),
-			StateID:       update.StateID,
-			StateName:     update.StateName,
-			Miner:         update.Miner,
-			PieceRef:      update.PieceRef,
-			Size:          update.Size,
-			PricePerEpoch: update.PricePerEpoch.Uint64(),
-			Duration:      update.Duration,
-		}
-		srv.Send(&pb.WatchReply{DealInfo: dealInfo})
+		reply := &pb.WatchReply{}
+		if update.Summary != nil {
+			reply.Summary = &pb.WatchSummary{Deals: make([]*pb.DealInfo, len(update.Summary.Deals))}
+			for i, di := range update.Summary.Deals {
+				reply.Summary.Deals[i] = toPbDealInfo(di)
+			}
+		} else {
+			reply.DealInfo = toPbDealInfo(update.Deal)
+		}
+		if err := srv.Send(reply); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// Estimate calls deals.Estimate, or deals.EstimateAuto if a replication factor
+// is specified
+func (s *Service) Estimate(ctx context.Context, req *pb.EstimateRequest) (*pb.EstimateReply, error) {
+	var e Estimation
+	var err error
+	if req.GetReplicationFactor() > 0 {
+		config := AutoConfig{
+			ReplicationFactor: int(req.GetReplicationFactor()),
+			MaxPrice:          req.GetMaxPrice(),
+		}
+		e, err = s.Module.EstimateAuto(ctx, req.GetSize(), req.GetDuration(), config)
+	} else {
+		var dealConfigs []DealConfig
+		dealConfigs, err = fromPbDealConfigs(req.GetDealConfigs())
+		if err != nil {
+			return nil, err
+		}
+		e, err = s.Module.Estimate(ctx, req.GetSize(), req.GetDuration(), dealConfigs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	replyDeals := make([]*pb.DealEstimation, len(e.Deals))
+	for i, de := range e.Deals {
+		replyDeals[i] = &pb.DealEstimation{
+			Miner:      de.Miner,
+			EpochPrice: bigString(de.EpochPrice),
+			Cost:       de.Cost.String(),
+			Warnings:   de.Warnings,
+		}
+	}
+	return &pb.EstimateReply{Total: e.Total.String(), Deals: replyDeals}, nil
+}
+
+// Retrieve calls deals.Retrieve
+func (s *Service) Retrieve(req *pb.RetrieveRequest, srv pb.API_RetrieveServer) error {
+	dataCid, err := cid.Decode(req.GetCid())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid data cid: %s", err)
+	}
+	var opts []RetrieveOption
+	if len(req.GetKey()) > 0 {
+		opts = append(opts, WithDecryptionKey(req.GetKey()))
+	}
+	reader, err := s.Module.Retrieve(srv.Context(), req.GetAddress(), dataCid, opts...)
+	if err == ErrDecryptionFailed {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return sendRetrieved(reader, srv.Send)
+}
+
+// sendRetrieved sends the data of reader in chunks
+func sendRetrieved(reader io.Reader, send func(*pb.RetrieveReply) error) error {
+	buffer := make([]byte, 1024*32) // 32KB
+	for {
+		bytesRead, err := reader.Read(buffer)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if bytesRead > 0 {
+			if sendErr := send(&pb.RetrieveReply{Chunk: buffer[:bytesRead]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// ListDeals calls deals.ListDeals
+func (s *Service) ListDeals(ctx context.Context, req *pb.ListDealsRequest) (*pb.ListDealsReply, error) {
+	records, err := s.Module.ListDeals()
+	if err != nil {
+		return nil, err
+	}
+	replyRecords := make([]*pb.DealRecord, len(records))
+	for i, record := range records {
+		replyRecords[i] = toPbDealRecord(record)
+	}
+	return &pb.ListDealsReply{Records: replyRecords}, nil
+}
+
+// GetDeal calls deals.GetDeal
+func (s *Service) GetDeal(ctx context.Context, req *pb.GetDealRequest) (*pb.GetDealReply, error) {
+	proposal, err := cid.Decode(req.GetProposalCid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proposal cid: %s", err)
+	}
+	record, err := s.Module.GetDeal(proposal)
+	if err == ErrDealNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDealReply{Record: toPbDealRecord(record)}, nil
+}
+
+func toPbStorageAsk(sa ask.StorageAsk) *pb.StorageAsk {
+	return &pb.StorageAsk{
+		Price:        sa.Price,
+		MinPieceSize: sa.MinPieceSize,
+		Miner:        sa.Miner,
+		Timestamp:    sa.Timestamp,
+		Expiry:       sa.Expiry,
+		Verified:     sa.Verified,
+	}
+}
+
+func toPbDealInfo(di DealInfo) *pb.DealInfo {
+	// deals that weren't polled yet have no update time
+	var updatedAt int64
+	if !di.UpdatedAt.IsZero() {
+		updatedAt = di.UpdatedAt.UnixNano()
+	}
+	return &pb.DealInfo{
+		ProposalCid:   di.ProposalCid.String(),
+		StateID:       di.StateID,
+		StateName:     di.StateName,
+		Miner:         di.Miner,
+		PieceRef:      di.PieceRef,
+		Size:          di.Size,
+		PricePerEpoch: bigString(di.PricePerEpoch),
+		Duration:      di.Duration,
+		UpdatedAt:     updatedAt,
+		RenewedBy:     cidString(di.RenewedBy),
+	}
+}
+
+func toPbDealRecord(dr DealRecord) *pb.DealRecord {
+	return &pb.DealRecord{
+		ProposalCid:     dr.ProposalCid.String(),
+		DataCid:         dr.DataCid.String(),
+		Address:         dr.Addr,
+		Miner:           dr.Miner,
+		EpochPrice:      bigString(dr.EpochPrice),
+		Duration:        dr.Duration,
+		CreatedAt:       dr.CreatedAt.UnixNano(),
+		Info:            toPbDealInfo(dr.Info),
+		ActivationEpoch: dr.ActivationEpoch,
+		RenewalOf:       cidString(dr.RenewalOf),
+		RenewedBy:       cidString(dr.RenewedBy),
+		ManifestCid:     cidString(dr.ManifestCid),
+		Encryption:      toPbEncryptionParams(dr.Encryption),
+		BatchCid:        cidString(dr.BatchCid),
+	}
+}
+
+func toPbBatch(b Batch) *pb.Batch {
+	files := make([]*pb.BatchEntry, len(b.Files))
+	for i, f := range b.Files {
+		files[i] = &pb.BatchEntry{Name: f.Name, Offset: f.Offset, Size: f.Size}
+	}
+	return &pb.Batch{
+		Cid:       b.Cid.String(),
+		DataCid:   b.DataCid.String(),
+		Size:      b.Size,
+		Files:     files,
+		CreatedAt: b.CreatedAt.UnixNano(),
+	}
+}
+
+func toPbEncryptionParams(params *EncryptionParams) *pb.EncryptionParams {
+	if params == nil {
+		return nil
+	}
+	return &pb.EncryptionParams{
+		Algorithm:  params.Algorithm,
+		Iv:         params.IV,
+		WrappedKey: params.WrappedKey,
+	}
+}
+
+func fromPbDealConfigs(dcs []*pb.DealConfig) ([]DealConfig, error) {
+	dealConfigs := make([]DealConfig, len(dcs))
+	for i, dealConfig := range dcs {
+		epochPrice, err := types.BigFromString(dealConfig.GetEpochPrice())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid epoch price %q for miner %s", dealConfig.GetEpochPrice(), dealConfig.GetMiner())
+		}
+		dealConfigs[i] = DealConfig{
+			Miner:      dealConfig.GetMiner(),
+			EpochPrice: epochPrice,
+		}
+	}
+	return dealConfigs, nil
+}
+
+// bigString returns the decimal string of b, or an empty string if it's nil
+func bigString(b types.BigInt) string {
+	if b.Nil() {
+		return ""
+	}
+	return b.String()
+}
+
+// cidString returns the string of c, or an empty string if it's undefined
+func cidString(c cid.Cid) string {
+	if !c.Defined() {
+		return ""
+	}
+	return c.String()
+}
+
+// CreateProfile calls deals.CreateProfile
+func (s *Service) CreateProfile(ctx context.Context, req *pb.CreateProfileRequest) (*pb.CreateProfileReply, error) {
+	if err := s.Module.CreateProfile(fromPbProfile(req.GetProfile())); err != nil {
+		return nil, toProfileError(err)
+	}
+	return &pb.CreateProfileReply{}, nil
+}
+
+// UpdateProfile calls deals.UpdateProfile
+func (s *Service) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileReply, error) {
+	if err := s.Module.UpdateProfile(fromPbProfile(req.GetProfile())); err != nil {
+		return nil, toProfileError(err)
+	}
+	return &pb.UpdateProfileReply{}, nil
+}
+
+// GetProfile calls deals.GetProfile
+func (s *Service) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileReply, error) {
+	p, err := s.Module.GetProfile(req.GetName())
+	if err != nil {
+		return nil, toProfileError(err)
+	}
+	return &pb.GetProfileReply{Profile: toPbProfile(p)}, nil
+}
+
+// ListProfiles calls deals.ListProfiles
+func (s *Service) ListProfiles(ctx context.Context, req *pb.ListProfilesRequest) (*pb.ListProfilesReply, error) {
+	profiles, err := s.Module.ListProfiles()
+	if err != nil {
+		return nil, err
+	}
+	replyProfiles := make([]*pb.Profile, len(profiles))
+	for i, p := range profiles {
+		replyProfiles[i] = toPbProfile(p)
+	}
+	return &pb.ListProfilesReply{Profiles: replyProfiles}, nil
+}
+
+// DeleteProfile calls deals.DeleteProfile
+func (s *Service) DeleteProfile(ctx context.Context, req *pb.DeleteProfileRequest) (*pb.DeleteProfileReply, error) {
+	if err := s.Module.DeleteProfile(req.GetName()); err != nil {
+		return nil, toProfileError(err)
+	}
+	return &pb.DeleteProfileReply{}, nil
+}
+
+func toProfileError(err error) error {
+	if _, ok := err.(*InvalidProfileError); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	switch err {
+	case ErrProfileNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrProfileExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return err
+	}
+}
+
+func toPbProfile(p Profile) *pb.Profile {
+	profile := &pb.Profile{
+		Name:              p.Name,
+		ReplicationFactor: int32(p.ReplicationFactor),
+		MaxPrice:          p.MaxPrice,
+		Duration:          p.Duration,
+		ExcludedCountries: p.ExcludedCountries,
+		MinReputation:     int32(p.MinReputation),
+	}
+	if p.RenewalPolicy != nil {
+		profile.RenewalPolicy = &pb.RenewalPolicy{
+			Threshold: p.RenewalPolicy.Threshold,
+			MaxPrice:  p.RenewalPolicy.MaxPrice,
+		}
+	}
+	return profile
+}
+
+func fromPbProfile(p *pb.Profile) Profile {
+	profile := Profile{
+		Name:              p.GetName(),
+		ReplicationFactor: int(p.GetReplicationFactor()),
+		MaxPrice:          p.GetMaxPrice(),
+		Duration:          p.GetDuration(),
+		ExcludedCountries: p.GetExcludedCountries(),
+		MinReputation:     int(p.GetMinReputation()),
+	}
+	if rp := p.GetRenewalPolicy(); rp != nil {
+		profile.RenewalPolicy = &RenewalPolicy{
+			Threshold: rp.GetThreshold(),
+			MaxPrice:  rp.GetMaxPrice(),
+		}
+	}
+	return profile
+}
+
+// RegisterWebhook calls deals.RegisterWebhook
+func (s *Service) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookReply, error) {
+	proposals := make([]cid.Cid, len(req.GetProposals()))
+	for i, proposal := range req.GetProposals() {
+		id, err := cid.Decode(proposal)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid proposal cid: %s", err)
+		}
+		proposals[i] = id
+	}
+	wh, err := s.Module.RegisterWebhook(req.GetUrl(), proposals, req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.RegisterWebhookReply{Webhook: toPbWebhook(wh)}, nil
+}
+
+// ListWebhooks calls deals.ListWebhooks
+func (s *Service) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+	webhooks, err := s.Module.ListWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	replyWebhooks := make([]*pb.Webhook, len(webhooks))
+	for i, wh := range webhooks {
+		replyWebhooks[i] = toPbWebhook(wh)
+		// the secret is only revealed on registration
+		replyWebhooks[i].Secret = nil
+	}
+	return &pb.ListWebhooksReply{Webhooks: replyWebhooks}, nil
+}
+
+// UnregisterWebhook calls deals.UnregisterWebhook
+func (s *Service) UnregisterWebhook(ctx context.Context, req *pb.UnregisterWebhookRequest) (*pb.UnregisterWebhookReply, error) {
+	err := s.Module.UnregisterWebhook(req.GetId())
+	if err == ErrWebhookNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnregisterWebhookReply{}, nil
+}
+
+// ListDeliveries calls deals.ListDeliveries
+func (s *Service) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.ListDeliveriesReply, error) {
+	deliveries, err := s.Module.ListDeliveries(req.GetWebhookID())
+	if err != nil {
+		return nil, err
+	}
+	replyDeliveries := make([]*pb.Delivery, len(deliveries))
+	for i, d := range deliveries {
+		var lastAttempt int64
+		if !d.LastAttempt.IsZero() {
+			lastAttempt = d.LastAttempt.UnixNano()
+		}
+		replyDeliveries[i] = &pb.Delivery{
+			Id:          d.ID,
+			WebhookID:   d.WebhookID,
+			Info:        toPbDealInfo(d.Info),
+			Attempts:    int32(d.Attempts),
+			LastAttempt: lastAttempt,
+			Delivered:   d.Delivered,
+			StatusCode:  int32(d.StatusCode),
+			Error:       d.Error,
+		}
+	}
+	return &pb.ListDeliveriesReply{Deliveries: replyDeliveries}, nil
+}
+
+func toPbWebhook(wh Webhook) *pb.Webhook {
+	proposals := make([]string, len(wh.Proposals))
+	for i, p := range wh.Proposals {
+		proposals[i] = p.String()
+	}
+	return &pb.Webhook{
+		Id:        wh.ID,
+		Url:       wh.URL,
+		Proposals: proposals,
+		Address:   wh.Addr,
+		Secret:    wh.Secret,
+		CreatedAt: wh.CreatedAt.UnixNano(),
+	}
+}
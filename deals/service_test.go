@@ -0,0 +1,169 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	pb "github.com/textileio/filecoin/deals/pb"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBigIntPrices(t *testing.T) {
+	t.Parallel()
+	// larger than math.MaxUint64
+	price, err := types.BigFromString("123456789012345678901234567890")
+	checkErr(t, err)
+
+	dcs, err := fromPbDealConfigs([]*pb.DealConfig{{Miner: "t01", EpochPrice: price.String()}})
+	checkErr(t, err)
+	if !dcs[0].EpochPrice.Equals(price) {
+		t.Fatalf("expected epoch price %s, got %s", price, dcs[0].EpochPrice)
+	}
+	di := toPbDealInfo(DealInfo{PricePerEpoch: price})
+	if di.GetPricePerEpoch() != price.String() {
+		t.Fatalf("expected price per epoch %s, got %s", price, di.GetPricePerEpoch())
+	}
+
+	if _, err := fromPbDealConfigs([]*pb.DealConfig{{Miner: "t01", EpochPrice: "1.5"}}); err == nil {
+		t.Fatal("expected an error for an invalid epoch price")
+	}
+}
+
+func TestPbCreatedAt(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Unix(1590000000, 123456789)
+	expected := createdAt.UnixNano()
+	if got := toPbDealRecord(DealRecord{CreatedAt: createdAt}).GetCreatedAt(); got != expected {
+		t.Fatalf("expected deal record creation %d, got %d", expected, got)
+	}
+	if got := toPbBatch(Batch{CreatedAt: createdAt}).GetCreatedAt(); got != expected {
+		t.Fatalf("expected batch creation %d, got %d", expected, got)
+	}
+	if got := toPbWebhook(Webhook{CreatedAt: createdAt}).GetCreatedAt(); got != expected {
+		t.Fatalf("expected webhook creation %d, got %d", expected, got)
+	}
+	if got := toPbDealInfo(DealInfo{}).GetUpdatedAt(); got != 0 {
+		t.Fatalf("expected zero update time for a deal that wasn't polled, got %d", got)
+	}
+}
+
+func TestAvailableAsksInvalidQuery(t *testing.T) {
+	t.Parallel()
+	s := &Service{}
+	queries := []*pb.Query{{Offset: -1}, {Limit: -1}, {SortBy: 42}}
+	for _, q := range queries {
+		_, err := s.AvailableAsks(context.Background(), &pb.AvailableAsksRequest{Query: q})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument for %v, got %v", q, err)
+		}
+	}
+}
+
+func TestProfileInvalidArgument(t *testing.T) {
+	t.Parallel()
+	s := &Service{Module: &Module{store: newDealStore(tests.NewTxMapDatastore())}}
+	invalid := &pb.Profile{Name: "archive", Duration: 1000}
+	_, err := s.CreateProfile(context.Background(), &pb.CreateProfileRequest{Profile: invalid})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument when creating, got %v", err)
+	}
+	_, err = s.UpdateProfile(context.Background(), &pb.UpdateProfileRequest{Profile: invalid})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument when updating, got %v", err)
+	}
+}
+
+func TestStoreLimits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		conf Config
+	}{
+		{name: "TooLarge", conf: Config{MaxUploadSize: 10}},
+		{name: "OverQuota", conf: Config{ImportDiskQuota: 10}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s, cleanup := newTestService(t, tt.conf)
+			defer cleanup()
+			chunk := &pb.StoreRequest{Payload: &pb.StoreRequest_Chunk{Chunk: bytes.Repeat([]byte{1}, 100)}}
+			srv := &fakeStoreServer{reqs: []*pb.StoreRequest{
+				{Payload: &pb.StoreRequest_StoreParams{StoreParams: &pb.StoreParams{Address: "t3addr", Duration: 100}}},
+				chunk,
+				chunk,
+			}}
+			done := make(chan error)
+			go func() { done <- s.Store(srv) }()
+			select {
+			case err := <-done:
+				if status.Code(err) != codes.ResourceExhausted {
+					t.Fatalf("expected ResourceExhausted, got %v", err)
+				}
+			case <-time.After(time.Second * 3):
+				t.Fatal("Store didn't return")
+			}
+		})
+	}
+}
+
+func TestReceiveStore(t *testing.T) {
+	t.Parallel()
+	storeFunc := func(r io.Reader) ([]cid.Cid, []FailedDeal, error) {
+		_, err := ioutil.ReadAll(r)
+		return nil, nil, err
+	}
+	chunks := [][]byte{[]byte("a"), []byte("b")}
+	recv := func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "bad payload")
+		}
+		c := chunks[0]
+		chunks = chunks[1:]
+		return c, nil
+	}
+	_, err := receiveStore(recv, storeFunc)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func newTestService(t *testing.T, conf Config) (*Service, func()) {
+	dir, err := ioutil.TempDir("", "service")
+	checkErr(t, err)
+	conf.ImportPath = dir
+	staging, err := newStaging(conf)
+	checkErr(t, err)
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	s := &Service{Module: &Module{api: api, store: store, tracker: newTracker(api, store), staging: staging}}
+	return s, func() { _ = os.RemoveAll(dir) }
+}
+
+type fakeStoreServer struct {
+	pb.API_StoreServer
+	reqs []*pb.StoreRequest
+}
+
+func (s *fakeStoreServer) Recv() (*pb.StoreRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *fakeStoreServer) Context() context.Context {
+	return context.Background()
+}
@@ -0,0 +1,193 @@
+package deals
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	importPattern   = "import-*"
+	retrievePattern = "retrieve-*"
+)
+
+var (
+	// ErrUploadTooLarge returns when the data to store exceeds the maximum
+	// upload size
+	ErrUploadTooLarge = errors.New("upload exceeds maximum size")
+	// ErrDiskQuotaExceeded returns when staging the data to store would exceed
+	// the disk quota of the import folder
+	ErrDiskQuotaExceeded = errors.New("import disk quota exceeded")
+)
+
+// staging manages the files that are handed to the Filecoin full-node for
+// importing or retrieving data
+type staging struct {
+	path          string
+	maxUploadSize int64
+	diskQuota     int64
+
+	lock sync.Mutex
+	used int64
+}
+
+// newStaging returns a new staging on the configured import path. Any file
+// left by a previous run is removed.
+func newStaging(conf Config) (*staging, error) {
+	path := conf.ImportPath
+	if path == "" {
+		// can't avoid home base path, ipfs checks: cannot add filestore references outside ipfs root (home folder)
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("error when getting home dir: %s", err)
+		}
+		path = filepath.Join(home, "textilefc")
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error when creating import folder: %s", err)
+	}
+	s := &staging{
+		path:          path,
+		maxUploadSize: conf.MaxUploadSize,
+		diskQuota:     conf.ImportDiskQuota,
+	}
+	if err := s.cleanup(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// stage copies data to a new file in the import folder, enforcing the maximum
+// upload size and disk quota. It returns the path and size of the file, and a
+// func that removes it which must be called when it's not needed anymore.
+func (s *staging) stage(data io.Reader) (string, uint64, func(), error) {
+	return s.stageLimited(data, s.maxUploadSize)
+}
+
+// stageDerived is like stage for data derived from a staged file, like its
+// encrypted copy, which only counts towards the disk quota since the maximum
+// upload size was already enforced on the original.
+func (s *staging) stageDerived(data io.Reader) (string, uint64, func(), error) {
+	return s.stageLimited(data, 0)
+}
+
+// stageLimited stages data enforcing maxSize, if it's greater than zero, and
+// the disk quota
+func (s *staging) stageLimited(data io.Reader, maxSize int64) (string, uint64, func(), error) {
+	f, err := ioutil.TempFile(s.path, importPattern)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("error when creating tmpfile: %s", err)
+	}
+	w := &stagingWriter{s: s, w: f, maxSize: maxSize}
+	release := func() {
+		_ = os.Remove(f.Name())
+		s.release(w.written)
+	}
+	_, err = io.Copy(w, data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		release()
+		if err == ErrUploadTooLarge || err == ErrDiskQuotaExceeded {
+			return "", 0, nil, err
+		}
+		return "", 0, nil, fmt.Errorf("error when copying data to tmpfile: %s", err)
+	}
+	return f.Name(), uint64(w.written), release, nil
+}
+
+// stageCopy copies data that's already accounted in the disk quota, like a
+// piece of a staged file, to a new file in the import folder. It returns the
+// path of the file, and a func that removes it which must be called when it's
+// not needed anymore.
+func (s *staging) stageCopy(data io.Reader) (string, func(), error) {
+	f, err := ioutil.TempFile(s.path, importPattern)
+	if err != nil {
+		return "", nil, fmt.Errorf("error when creating tmpfile: %s", err)
+	}
+	release := func() {
+		_ = os.Remove(f.Name())
+	}
+	_, err = io.Copy(f, data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		release()
+		return "", nil, fmt.Errorf("error when copying data to tmpfile: %s", err)
+	}
+	return f.Name(), release, nil
+}
+
+// tempFile returns the path of a new empty file in the import folder
+func (s *staging) tempFile(pattern string) (string, error) {
+	f, err := ioutil.TempFile(s.path, pattern)
+	if err != nil {
+		return "", fmt.Errorf("error when creating tmpfile: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("error when closing tmpfile: %s", err)
+	}
+	return f.Name(), nil
+}
+
+// reserve accounts n more bytes in the disk quota
+func (s *staging) reserve(n int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.diskQuota > 0 && s.used+n > s.diskQuota {
+		return ErrDiskQuotaExceeded
+	}
+	s.used += n
+	return nil
+}
+
+// release frees n bytes from the disk quota
+func (s *staging) release(n int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.used -= n
+}
+
+// cleanup removes files left by crashed uploads or retrievals
+func (s *staging) cleanup() error {
+	for _, pattern := range []string{importPattern, retrievePattern} {
+		matches, err := filepath.Glob(filepath.Join(s.path, pattern))
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			log.Infof("removing stale staged file %s", m)
+			if err := os.Remove(m); err != nil {
+				return fmt.Errorf("error when removing stale staged file: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// stagingWriter writes into a staged file, enforcing maxSize and the disk
+// quota
+type stagingWriter struct {
+	s       *staging
+	w       io.Writer
+	maxSize int64
+	written int64
+}
+
+func (sw *stagingWriter) Write(p []byte) (int, error) {
+	n := int64(len(p))
+	if sw.maxSize > 0 && sw.written+n > sw.maxSize {
+		return 0, ErrUploadTooLarge
+	}
+	if err := sw.s.reserve(n); err != nil {
+		return 0, err
+	}
+	sw.written += n
+	return sw.w.Write(p)
+}
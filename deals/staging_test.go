@@ -0,0 +1,68 @@
+package deals
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStagingLimits(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "staging")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := newStaging(Config{ImportPath: dir, MaxUploadSize: 100, ImportDiskQuota: 150})
+	checkErr(t, err)
+
+	if _, _, _, err := s.stage(bytes.NewReader(make([]byte, 101))); err != ErrUploadTooLarge {
+		t.Fatalf("expected ErrUploadTooLarge, got %v", err)
+	}
+	path, size, release, err := s.stage(bytes.NewReader(make([]byte, 100)))
+	checkErr(t, err)
+	if size != 100 {
+		t.Fatalf("expected staged size 100, got %d", size)
+	}
+	if _, _, _, err := s.stage(bytes.NewReader(make([]byte, 60))); err != ErrDiskQuotaExceeded {
+		t.Fatalf("expected ErrDiskQuotaExceeded, got %v", err)
+	}
+	release()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("staged file wasn't removed: %v", err)
+	}
+	_, _, release, err = s.stage(bytes.NewReader(make([]byte, 60)))
+	checkErr(t, err)
+	release()
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*"))
+	checkErr(t, err)
+	if len(matches) != 0 {
+		t.Fatalf("expected empty import folder, got %v", matches)
+	}
+}
+
+func TestStagingCleanup(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "staging")
+	checkErr(t, err)
+	defer os.RemoveAll(dir)
+
+	stale := []string{"import-123", "retrieve-456"}
+	for _, name := range stale {
+		checkErr(t, ioutil.WriteFile(filepath.Join(dir, name), []byte("crashed"), 0644))
+	}
+	checkErr(t, ioutil.WriteFile(filepath.Join(dir, "other"), []byte("keep"), 0644))
+
+	_, err = newStaging(Config{ImportPath: dir})
+	checkErr(t, err)
+	for _, name := range stale {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Fatalf("stale file %s wasn't removed", name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "other")); err != nil {
+		t.Fatalf("unrelated file was removed: %v", err)
+	}
+}
@@ -0,0 +1,449 @@
+package deals
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var (
+	// ErrDealNotFound returns when the deal isn't in the store
+	ErrDealNotFound = errors.New("deal not found")
+	// ErrManifestNotFound returns when the manifest isn't in the store
+	ErrManifestNotFound = errors.New("manifest not found")
+	// ErrProfileNotFound returns when the profile isn't in the store
+	ErrProfileNotFound = errors.New("profile not found")
+	// ErrBatchNotFound returns when the batch isn't in the store
+	ErrBatchNotFound = errors.New("batch not found")
+	// ErrFileNotFound returns when the batch doesn't contain the file
+	ErrFileNotFound = errors.New("file not found in batch")
+
+	dsBaseDeals       = datastore.NewKey("/deals")
+	dsBaseTransitions = datastore.NewKey("/transitions")
+	dsBaseManifests   = datastore.NewKey("/manifests")
+	dsBaseBatches     = datastore.NewKey("/batches")
+	dsBaseProfiles    = datastore.NewKey("/profiles")
+	dsBaseWebhooks    = datastore.NewKey("/webhooks")
+	dsBaseDeliveries  = datastore.NewKey("/deliveries")
+	dsBasePending     = datastore.NewKey("/pending")
+)
+
+// dealStore persists DealRecords created by the Module
+type dealStore struct {
+	ds datastore.TxnDatastore
+}
+
+func newDealStore(ds datastore.TxnDatastore) *dealStore {
+	return &dealStore{
+		ds: ds,
+	}
+}
+
+// put creates or overwrites a DealRecord
+func (s *dealStore) put(dr DealRecord) error {
+	b, err := json.Marshal(&dr)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genDealKey(dr.ProposalCid), b)
+}
+
+// get returns the DealRecord of a proposal
+func (s *dealStore) get(proposal cid.Cid) (DealRecord, error) {
+	b, err := s.ds.Get(genDealKey(proposal))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return DealRecord{}, ErrDealNotFound
+		}
+		return DealRecord{}, err
+	}
+	var dr DealRecord
+	if err := json.Unmarshal(b, &dr); err != nil {
+		return DealRecord{}, err
+	}
+	return dr, nil
+}
+
+// getAll returns all stored DealRecords
+func (s *dealStore) getAll() ([]DealRecord, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: dsBaseDeals.String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []DealRecord
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var dr DealRecord
+		if err := json.Unmarshal(r.Value, &dr); err != nil {
+			return nil, err
+		}
+		ret = append(ret, dr)
+	}
+	return ret, nil
+}
+
+// putTransition records a new observed state of a deal
+func (s *dealStore) putTransition(di DealInfo) error {
+	b, err := json.Marshal(&di)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genTransitionKey(di.ProposalCid, di.UpdatedAt), b)
+}
+
+// getTransitions returns the recorded states of proposals that happened after
+// since, sorted by time.
+func (s *dealStore) getTransitions(proposals []cid.Cid, since time.Time) ([]DealInfo, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	var ret []DealInfo
+	for _, p := range proposals {
+		res, err := txn.Query(query.Query{Prefix: dsBaseTransitions.ChildString(p.String()).String()})
+		if err != nil {
+			return nil, err
+		}
+		for r := range res.Next() {
+			if r.Error != nil {
+				res.Close()
+				return nil, r.Error
+			}
+			var di DealInfo
+			if err := json.Unmarshal(r.Value, &di); err != nil {
+				res.Close()
+				return nil, err
+			}
+			if di.UpdatedAt.After(since) {
+				ret = append(ret, di)
+			}
+		}
+		res.Close()
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].UpdatedAt.Before(ret[j].UpdatedAt)
+	})
+	return ret, nil
+}
+
+// putManifest creates or overwrites a Manifest
+func (s *dealStore) putManifest(mf Manifest) error {
+	b, err := json.Marshal(&mf)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genManifestKey(mf.Cid), b)
+}
+
+// getManifest returns the Manifest identified by c
+func (s *dealStore) getManifest(c cid.Cid) (Manifest, error) {
+	b, err := s.ds.Get(genManifestKey(c))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return Manifest{}, ErrManifestNotFound
+		}
+		return Manifest{}, err
+	}
+	var mf Manifest
+	if err := json.Unmarshal(b, &mf); err != nil {
+		return Manifest{}, err
+	}
+	return mf, nil
+}
+
+// putBatch creates or overwrites a Batch
+func (s *dealStore) putBatch(b Batch) error {
+	buf, err := json.Marshal(&b)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genBatchKey(b.Cid), buf)
+}
+
+// getBatch returns the Batch identified by c
+func (s *dealStore) getBatch(c cid.Cid) (Batch, error) {
+	buf, err := s.ds.Get(genBatchKey(c))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return Batch{}, ErrBatchNotFound
+		}
+		return Batch{}, err
+	}
+	var b Batch
+	if err := json.Unmarshal(buf, &b); err != nil {
+		return Batch{}, err
+	}
+	return b, nil
+}
+
+// putProfile creates or overwrites a Profile
+func (s *dealStore) putProfile(p Profile) error {
+	b, err := json.Marshal(&p)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genProfileKey(p.Name), b)
+}
+
+// getProfile returns the Profile with the indicated name
+func (s *dealStore) getProfile(name string) (Profile, error) {
+	b, err := s.ds.Get(genProfileKey(name))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return Profile{}, ErrProfileNotFound
+		}
+		return Profile{}, err
+	}
+	var p Profile
+	if err := json.Unmarshal(b, &p); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
+
+// getAllProfiles returns all stored Profiles
+func (s *dealStore) getAllProfiles() ([]Profile, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: dsBaseProfiles.String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []Profile
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var p Profile
+		if err := json.Unmarshal(r.Value, &p); err != nil {
+			return nil, err
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+// deleteProfile removes the Profile with the indicated name
+func (s *dealStore) deleteProfile(name string) error {
+	key := genProfileKey(name)
+	exists, err := s.ds.Has(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProfileNotFound
+	}
+	return s.ds.Delete(key)
+}
+
+// putWebhook creates or overwrites a Webhook
+func (s *dealStore) putWebhook(wh Webhook) error {
+	b, err := json.Marshal(&wh)
+	if err != nil {
+		return err
+	}
+	return s.ds.Put(genWebhookKey(wh.ID), b)
+}
+
+// getAllWebhooks returns all stored Webhooks
+func (s *dealStore) getAllWebhooks() ([]Webhook, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: dsBaseWebhooks.String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []Webhook
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var wh Webhook
+		if err := json.Unmarshal(r.Value, &wh); err != nil {
+			return nil, err
+		}
+		ret = append(ret, wh)
+	}
+	return ret, nil
+}
+
+// deleteWebhook removes the Webhook with the indicated id, and stops sending
+// its pending Deliveries
+func (s *dealStore) deleteWebhook(id string) error {
+	key := genWebhookKey(id)
+	txn, err := s.ds.NewTransaction(false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	exists, err := txn.Has(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrWebhookNotFound
+	}
+	if err := txn.Delete(key); err != nil {
+		return err
+	}
+	res, err := txn.Query(query.Query{Prefix: dsBasePending.ChildString(id).String(), KeysOnly: true})
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		if err := txn.Delete(datastore.NewKey(r.Key)); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+// putDelivery creates or overwrites a Delivery, indexing it while it's
+// pending
+func (s *dealStore) putDelivery(d Delivery) error {
+	b, err := json.Marshal(&d)
+	if err != nil {
+		return err
+	}
+	txn, err := s.ds.NewTransaction(false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	if err := txn.Put(genDeliveryKey(d.WebhookID, d.ID), b); err != nil {
+		return err
+	}
+	pendingKey := genPendingKey(d.WebhookID, d.ID)
+	if d.pending() {
+		err = txn.Put(pendingKey, []byte{})
+	} else {
+		err = txn.Delete(pendingKey)
+	}
+	if err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+// getPendingDeliveries returns the Deliveries of every webhook that weren't
+// delivered and have attempts left, sorted by creation
+func (s *dealStore) getPendingDeliveries() ([]Delivery, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: dsBasePending.String(), KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []Delivery
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		key := datastore.NewKey(r.Key)
+		b, err := txn.Get(genDeliveryKey(key.Parent().Name(), key.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var d Delivery
+		if err := json.Unmarshal(b, &d); err != nil {
+			return nil, err
+		}
+		ret = append(ret, d)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret, nil
+}
+
+// getDeliveries returns the Deliveries of a webhook, sorted by creation
+func (s *dealStore) getDeliveries(webhookID string) ([]Delivery, error) {
+	txn, err := s.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: dsBaseDeliveries.ChildString(webhookID).String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []Delivery
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var d Delivery
+		if err := json.Unmarshal(r.Value, &d); err != nil {
+			return nil, err
+		}
+		ret = append(ret, d)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+	return ret, nil
+}
+
+func genDealKey(proposal cid.Cid) datastore.Key {
+	return dsBaseDeals.ChildString(proposal.String())
+}
+
+func genTransitionKey(proposal cid.Cid, t time.Time) datastore.Key {
+	return dsBaseTransitions.ChildString(proposal.String()).ChildString(fmt.Sprintf("%020d", t.UnixNano()))
+}
+
+func genManifestKey(c cid.Cid) datastore.Key {
+	return dsBaseManifests.ChildString(c.String())
+}
+
+func genBatchKey(c cid.Cid) datastore.Key {
+	return dsBaseBatches.ChildString(c.String())
+}
+
+func genProfileKey(name string) datastore.Key {
+	return dsBaseProfiles.ChildString(name)
+}
+
+func genWebhookKey(id string) datastore.Key {
+	return dsBaseWebhooks.ChildString(id)
+}
+
+func genDeliveryKey(webhookID, id string) datastore.Key {
+	return dsBaseDeliveries.ChildString(webhookID).ChildString(id)
+}
+
+func genPendingKey(webhookID, id string) datastore.Key {
+	return dsBasePending.ChildString(webhookID).ChildString(id)
+}
@@ -0,0 +1,99 @@
+package deals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestDealStore(t *testing.T) {
+	t.Parallel()
+	s := newDealStore(tests.NewTxMapDatastore())
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	if _, err := s.get(proposal); err != ErrDealNotFound {
+		t.Fatalf("expected ErrDealNotFound, got %v", err)
+	}
+
+	dr := DealRecord{
+		ProposalCid: proposal,
+		DataCid:     proposal,
+		Addr:        "t3addr",
+		Miner:       "t0100",
+		EpochPrice:  types.NewInt(42),
+		Duration:    1000,
+		CreatedAt:   time.Unix(time.Now().Unix(), 0),
+		Info: DealInfo{
+			ProposalCid:   proposal,
+			StateID:       types.DealAccepted,
+			StateName:     types.DealStates[types.DealAccepted],
+			Miner:         "t0100",
+			PricePerEpoch: types.NewInt(42),
+			Duration:      1000,
+		},
+	}
+	checkErr(t, s.put(dr))
+
+	got, err := s.get(proposal)
+	checkErr(t, err)
+	if !got.ProposalCid.Equals(dr.ProposalCid) || got.Miner != dr.Miner ||
+		!got.EpochPrice.Equals(dr.EpochPrice) || !got.CreatedAt.Equal(dr.CreatedAt) ||
+		got.Info.StateID != dr.Info.StateID {
+		t.Fatalf("expected %v, got %v", dr, got)
+	}
+
+	all, err := s.getAll()
+	checkErr(t, err)
+	if len(all) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(all))
+	}
+}
+
+func TestPendingDeliveries(t *testing.T) {
+	t.Parallel()
+	s := newDealStore(tests.NewTxMapDatastore())
+	checkErr(t, s.putWebhook(Webhook{ID: "wh1"}))
+	checkErr(t, s.putWebhook(Webhook{ID: "wh2"}))
+	checkErr(t, s.putDelivery(Delivery{ID: "1", WebhookID: "wh1"}))
+	checkErr(t, s.putDelivery(Delivery{ID: "2", WebhookID: "wh1", Delivered: true}))
+	checkErr(t, s.putDelivery(Delivery{ID: "3", WebhookID: "wh1", Attempts: webhookMaxAttempts}))
+	checkErr(t, s.putDelivery(Delivery{ID: "4", WebhookID: "wh2", Attempts: 1}))
+
+	expectPending := func(ids ...string) {
+		t.Helper()
+		pending, err := s.getPendingDeliveries()
+		checkErr(t, err)
+		if len(pending) != len(ids) {
+			t.Fatalf("expected pending deliveries %v, got %v", ids, pending)
+		}
+		for i, d := range pending {
+			if d.ID != ids[i] {
+				t.Fatalf("expected pending deliveries %v, got %v", ids, pending)
+			}
+		}
+	}
+	expectPending("1", "4")
+
+	checkErr(t, s.putDelivery(Delivery{ID: "1", WebhookID: "wh1", Attempts: 1, Delivered: true}))
+	expectPending("4")
+	checkErr(t, s.deleteWebhook("wh2"))
+	expectPending()
+
+	// the delivery log is kept
+	deliveries, err := s.getDeliveries("wh1")
+	checkErr(t, err)
+	if len(deliveries) != 3 {
+		t.Fatalf("expected 3 deliveries, got %d", len(deliveries))
+	}
+}
+
+func checkErr(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
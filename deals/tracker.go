@@ -0,0 +1,290 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+const subscribeAllBuffer = 100
+
+// tracker keeps the state of persisted non-terminal deals, and any proposal
+// that is being watched, up to date. Every detected state transition is
+// recorded and published to subscribers.
+type tracker struct {
+	api   API
+	store *dealStore
+
+	refresh chan struct{}
+
+	lock   sync.Mutex
+	adhoc  map[cid.Cid]int
+	last   map[cid.Cid]DealInfo
+	subs   map[*subscription]struct{}
+	closed bool
+}
+
+// subscription receives state transitions of a set of proposals, or of every
+// proposal if all is true. States are dropped if the subscriber falls behind,
+// unless the subscription is lossless.
+type subscription struct {
+	all       bool
+	lossless  bool
+	proposals map[cid.Cid]struct{}
+	ch        chan DealInfo
+
+	// lock guards ch from being closed while a state is sent
+	lock   sync.Mutex
+	closed bool
+}
+
+// send delivers di to the subscription, dropping it if ch stays blocked for
+// chanWriteTimeout. Lossless subscriptions wait until ch has room instead. It
+// returns false if ctx is done.
+func (sub *subscription) send(ctx context.Context, di DealInfo) bool {
+	sub.lock.Lock()
+	defer sub.lock.Unlock()
+	if sub.closed {
+		return true
+	}
+	var timeout <-chan time.Time
+	if !sub.lossless {
+		timeout = time.After(chanWriteTimeout)
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case sub.ch <- di:
+	case <-timeout:
+		log.Warnf("dropping new state since chan is blocked")
+	}
+	return true
+}
+
+// close closes ch, waiting for any state being sent
+func (sub *subscription) close() {
+	sub.lock.Lock()
+	defer sub.lock.Unlock()
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+}
+
+func newTracker(api API, store *dealStore) *tracker {
+	return &tracker{
+		api:     api,
+		store:   store,
+		refresh: make(chan struct{}, 1),
+		adhoc:   make(map[cid.Cid]int),
+		last:    make(map[cid.Cid]DealInfo),
+		subs:    make(map[*subscription]struct{}),
+	}
+}
+
+// requestRefresh asks for a new poll of deal states as soon as possible
+func (t *tracker) requestRefresh() {
+	select {
+	case t.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe returns a subscription to state transitions of proposals. Proposals
+// that aren't persisted are tracked until the subscription is cancelled.
+func (t *tracker) subscribe(proposals []cid.Cid) *subscription {
+	sub := &subscription{
+		proposals: make(map[cid.Cid]struct{}, len(proposals)),
+		ch:        make(chan DealInfo, len(proposals)+1),
+	}
+	t.lock.Lock()
+	if t.closed {
+		t.lock.Unlock()
+		sub.close()
+		return sub
+	}
+	for _, p := range proposals {
+		sub.proposals[p] = struct{}{}
+		t.adhoc[p]++
+	}
+	t.subs[sub] = struct{}{}
+	t.lock.Unlock()
+	t.requestRefresh()
+	return sub
+}
+
+// subscribeAll returns a lossless subscription to state transitions of every
+// tracked proposal. Since polling waits for it, the subscriber must keep
+// draining ch until it's closed or the polling context is done.
+func (t *tracker) subscribeAll() *subscription {
+	sub := &subscription{
+		all:      true,
+		lossless: true,
+		ch:       make(chan DealInfo, subscribeAllBuffer),
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.closed {
+		sub.close()
+		return sub
+	}
+	t.subs[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe cancels a subscription
+func (t *tracker) unsubscribe(sub *subscription) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, ok := t.subs[sub]; !ok {
+		return
+	}
+	for p := range sub.proposals {
+		t.adhoc[p]--
+		if t.adhoc[p] == 0 {
+			delete(t.adhoc, p)
+			delete(t.last, p)
+		}
+	}
+	delete(t.subs, sub)
+	sub.close()
+}
+
+// poll fetches the current state of every tracked proposal and records the
+// ones that changed.
+func (t *tracker) poll(ctx context.Context) {
+	records, err := t.store.getAll()
+	if err != nil {
+		log.Errorf("error when getting deal records: %s", err)
+		return
+	}
+	tracked := make(map[cid.Cid]*DealRecord)
+	finished := make(map[cid.Cid]struct{})
+	for i := range records {
+		if isTerminal(records[i].Info.StateID) {
+			finished[records[i].ProposalCid] = struct{}{}
+			continue
+		}
+		tracked[records[i].ProposalCid] = &records[i]
+	}
+	t.lock.Lock()
+	for p := range t.adhoc {
+		// the final state of persisted deals is already recorded
+		if _, ok := finished[p]; ok {
+			continue
+		}
+		if _, ok := tracked[p]; !ok {
+			tracked[p] = nil
+		}
+	}
+	t.lock.Unlock()
+
+	for pcid, dr := range tracked {
+		dinfo, err := t.api.ClientGetDealInfo(ctx, pcid)
+		if err != nil {
+			log.Errorf("error when getting deal proposal info %s: %s", pcid, err)
+			continue
+		}
+		newState := DealInfo{
+			ProposalCid:   pcid,
+			StateID:       dinfo.State,
+			StateName:     types.DealStates[dinfo.State],
+			Miner:         dinfo.Provider,
+			PieceRef:      dinfo.PieceRef,
+			Size:          dinfo.Size,
+			PricePerEpoch: dinfo.PricePerEpoch,
+			Duration:      dinfo.Duration,
+			UpdatedAt:     time.Now(),
+		}
+		var prevState DealInfo
+		if dr != nil {
+			prevState = dr.Info
+		} else {
+			t.lock.Lock()
+			prevState = t.last[pcid]
+			t.lock.Unlock()
+		}
+		if sameDealState(prevState, newState) {
+			continue
+		}
+		if err := t.store.putTransition(newState); err != nil {
+			log.Errorf("error when saving deal transition %s: %s", pcid, err)
+			continue
+		}
+		if dr != nil {
+			dr.Info = newState
+			if err := t.store.put(*dr); err != nil {
+				log.Errorf("error when saving deal state %s: %s", pcid, err)
+			}
+		}
+		t.lock.Lock()
+		if _, ok := t.adhoc[pcid]; ok {
+			t.last[pcid] = newState
+		}
+		t.lock.Unlock()
+		t.publish(ctx, newState)
+	}
+}
+
+// publish sends a state transition to every interested subscriber
+func (t *tracker) publish(ctx context.Context, di DealInfo) {
+	t.lock.Lock()
+	var subs []*subscription
+	for sub := range t.subs {
+		if _, ok := sub.proposals[di.ProposalCid]; ok || sub.all {
+			subs = append(subs, sub)
+		}
+	}
+	t.lock.Unlock()
+	// states are sent without holding the lock, so a slow subscriber doesn't
+	// block others from subscribing or unsubscribing
+	for _, sub := range subs {
+		if !sub.send(ctx, di) {
+			return
+		}
+	}
+}
+
+// close cancels every active subscription
+func (t *tracker) close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for sub := range t.subs {
+		sub.close()
+	}
+	t.subs = make(map[*subscription]struct{})
+	t.adhoc = make(map[cid.Cid]int)
+	t.closed = true
+}
+
+// isTerminal returns true if the deal state won't change anymore
+func isTerminal(state uint64) bool {
+	switch state {
+	case types.DealComplete, types.DealFailed, types.DealRejected, types.DealError:
+		return true
+	default:
+		return false
+	}
+}
+
+// sameDealState returns true if both DealInfo describe the same deal state,
+// regardless of when they were observed.
+func sameDealState(a, b DealInfo) bool {
+	return a.StateID == b.StateID &&
+		a.Miner == b.Miner &&
+		a.Size == b.Size &&
+		a.Duration == b.Duration &&
+		bytes.Equal(a.PieceRef, b.PieceRef) &&
+		sameBigInt(a.PricePerEpoch, b.PricePerEpoch)
+}
+
+func sameBigInt(a, b types.BigInt) bool {
+	if a.Nil() || b.Nil() {
+		return a.Nil() == b.Nil()
+	}
+	return a.Equals(b)
+}
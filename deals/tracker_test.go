@@ -0,0 +1,185 @@
+package deals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestTrackerPoll(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	tr := newTracker(api, store)
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	checkErr(t, store.put(DealRecord{ProposalCid: proposal, Miner: "t0100", EpochPrice: types.NewInt(10)}))
+
+	api.setState(proposal, "t0100", types.DealAccepted)
+	tr.poll(ctx)
+	tr.poll(ctx)
+	api.setState(proposal, "t0100", types.DealComplete)
+	tr.poll(ctx)
+
+	trs, err := store.getTransitions([]cid.Cid{proposal}, time.Time{})
+	checkErr(t, err)
+	if len(trs) != 2 || trs[0].StateID != types.DealAccepted || trs[1].StateID != types.DealComplete {
+		t.Fatalf("unexpected transitions %v", trs)
+	}
+	dr, err := store.get(proposal)
+	checkErr(t, err)
+	if dr.Info.StateID != types.DealComplete {
+		t.Fatalf("expected persisted state %d, got %d", types.DealComplete, dr.Info.StateID)
+	}
+
+	// Terminal deals aren't polled anymore
+	api.setState(proposal, "t0100", types.DealFailed)
+	tr.poll(ctx)
+	dr, err = store.get(proposal)
+	checkErr(t, err)
+	if dr.Info.StateID != types.DealComplete {
+		t.Fatalf("terminal deal was updated to %d", dr.Info.StateID)
+	}
+
+	since := trs[0].UpdatedAt
+	trs, err = store.getTransitions([]cid.Cid{proposal}, since)
+	checkErr(t, err)
+	if len(trs) != 1 || trs[0].StateID != types.DealComplete {
+		t.Fatalf("unexpected transitions since %v: %v", since, trs)
+	}
+}
+
+func TestTrackerWatchFinished(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	tr := newTracker(api, store)
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	checkErr(t, store.put(DealRecord{ProposalCid: proposal, Miner: "t0100", EpochPrice: types.NewInt(10)}))
+	api.setState(proposal, "t0100", types.DealComplete)
+	tr.poll(ctx)
+
+	// watching the completed deal twice doesn't record its state again
+	for i := 0; i < 2; i++ {
+		sub := tr.subscribe([]cid.Cid{proposal})
+		tr.poll(ctx)
+		tr.unsubscribe(sub)
+	}
+	trs, err := store.getTransitions([]cid.Cid{proposal}, time.Time{})
+	checkErr(t, err)
+	if len(trs) != 1 || trs[0].StateID != types.DealComplete {
+		t.Fatalf("unexpected transitions %v", trs)
+	}
+}
+
+func TestTrackerSubscription(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := newMockAPI()
+	tr := newTracker(api, newDealStore(tests.NewTxMapDatastore()))
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	api.setState(proposal, "t0100", types.DealStaged)
+
+	sub := tr.subscribe([]cid.Cid{proposal})
+	tr.poll(ctx)
+	select {
+	case di := <-sub.ch:
+		if !di.ProposalCid.Equals(proposal) || di.StateID != types.DealStaged {
+			t.Fatalf("unexpected state %v", di)
+		}
+	default:
+		t.Fatal("expected a published state transition")
+	}
+
+	tr.unsubscribe(sub)
+	if _, ok := <-sub.ch; ok {
+		t.Fatal("subscription channel should be closed")
+	}
+}
+
+func TestTrackerSlowSubscriber(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tr := newTracker(newMockAPI(), newDealStore(tests.NewTxMapDatastore()))
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+
+	// the buffer of the slow subscription gets full and it's never read
+	slow := tr.subscribe([]cid.Cid{proposal})
+	for i := 0; i < cap(slow.ch); i++ {
+		tr.publish(ctx, DealInfo{ProposalCid: proposal})
+	}
+	published := make(chan struct{})
+	go func() {
+		tr.publish(ctx, DealInfo{ProposalCid: proposal})
+		close(published)
+	}()
+	// let the publish get blocked by the slow subscription
+	time.Sleep(time.Millisecond * 100)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		sub := tr.subscribe([]cid.Cid{proposal})
+		tr.unsubscribe(sub)
+	}
+	if time.Since(start) >= chanWriteTimeout/2 {
+		t.Fatal("subscriptions were blocked by a slow subscriber")
+	}
+
+	<-published
+	tr.unsubscribe(slow)
+	for i := 0; i < cap(slow.ch); i++ {
+		if _, ok := <-slow.ch; !ok {
+			t.Fatal("expected the buffered states")
+		}
+	}
+	if _, ok := <-slow.ch; ok {
+		t.Fatal("subscription channel should be closed")
+	}
+}
+
+func TestTrackerSubscribeAllLossless(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tr := newTracker(newMockAPI(), newDealStore(tests.NewTxMapDatastore()))
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+
+	sub := tr.subscribeAll()
+	published := make(chan struct{})
+	go func() {
+		for i := 0; i <= cap(sub.ch); i++ {
+			tr.publish(ctx, DealInfo{ProposalCid: proposal, StateID: uint64(i)})
+		}
+		close(published)
+	}()
+	// the last state waits for room past the write timeout
+	time.Sleep(chanWriteTimeout + time.Millisecond*200)
+	select {
+	case <-published:
+		t.Fatal("expected publish to wait for the subscriber")
+	default:
+	}
+
+	for i := 0; i <= cap(sub.ch); i++ {
+		if di := <-sub.ch; di.StateID != uint64(i) {
+			t.Fatalf("expected state %d, got %d", i, di.StateID)
+		}
+	}
+	<-published
+	tr.close()
+	if _, ok := <-sub.ch; ok {
+		t.Fatal("subscription channel should be closed")
+	}
+}
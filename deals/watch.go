@@ -0,0 +1,123 @@
+package deals
+
+import (
+	"github.com/ipfs/go-cid"
+)
+
+// WatchConfig contains optional settings for Watch
+type WatchConfig struct {
+	// Miners restricts the sent state changes to deals with these miners
+	Miners []string
+	// States restricts the sent state changes to these states
+	States []uint64
+	// UntilDone closes the channel with a WatchSummary once every watched
+	// proposal reaches a terminal state
+	UntilDone bool
+}
+
+// WatchOption modifies a WatchConfig
+type WatchOption func(*WatchConfig)
+
+// WithMiners only sends state changes of deals with miners
+func WithMiners(miners ...string) WatchOption {
+	return func(c *WatchConfig) {
+		c.Miners = append(c.Miners, miners...)
+	}
+}
+
+// WithStates only sends state changes to states
+func WithStates(states ...uint64) WatchOption {
+	return func(c *WatchConfig) {
+		c.States = append(c.States, states...)
+	}
+}
+
+// UntilDone closes the Watch channel once every proposal reaches a terminal
+// state, after sending a WatchSummary
+func UntilDone() WatchOption {
+	return func(c *WatchConfig) {
+		c.UntilDone = true
+	}
+}
+
+// WatchEvent is a state change of a watched proposal, or the final summary
+// when watching until done
+type WatchEvent struct {
+	Deal    DealInfo
+	Summary *WatchSummary
+}
+
+// WatchSummary contains the final state of every watched proposal
+type WatchSummary struct {
+	Deals []DealInfo
+}
+
+// watchState keeps the last known state of watched proposals to filter state
+// changes and detect when all of them are done
+type watchState struct {
+	config    WatchConfig
+	proposals []cid.Cid
+	last      map[cid.Cid]DealInfo
+}
+
+func newWatchState(proposals []cid.Cid, config WatchConfig) *watchState {
+	return &watchState{
+		config:    config,
+		proposals: proposals,
+		last:      make(map[cid.Cid]DealInfo, len(proposals)),
+	}
+}
+
+// update records di and returns true if it's a new state that should be sent
+func (ws *watchState) update(di DealInfo) bool {
+	if prev, ok := ws.last[di.ProposalCid]; ok && !di.UpdatedAt.After(prev.UpdatedAt) {
+		return false
+	}
+	ws.last[di.ProposalCid] = di
+	return ws.matches(di)
+}
+
+// matches returns true if di satisfies the miner and state filters
+func (ws *watchState) matches(di DealInfo) bool {
+	if len(ws.config.Miners) > 0 {
+		found := false
+		for _, m := range ws.config.Miners {
+			if m == di.Miner {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(ws.config.States) > 0 {
+		for _, s := range ws.config.States {
+			if s == di.StateID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// done returns true if every watched proposal reached a terminal state
+func (ws *watchState) done() bool {
+	for _, p := range ws.proposals {
+		di, ok := ws.last[p]
+		if !ok || !isTerminal(di.StateID) {
+			return false
+		}
+	}
+	return true
+}
+
+// summary returns the last known state of every watched proposal
+func (ws *watchState) summary() *WatchSummary {
+	s := &WatchSummary{Deals: make([]DealInfo, len(ws.proposals))}
+	for i, p := range ws.proposals {
+		s.Deals[i] = ws.last[p]
+	}
+	return s
+}
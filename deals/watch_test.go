@@ -0,0 +1,69 @@
+package deals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestWatchFiltersUntilDone(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	api := newMockAPI()
+	store := newDealStore(tests.NewTxMapDatastore())
+	m := &Module{api: api, store: store, tracker: newTracker(api, store)}
+
+	p1, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	p2, err := cid.Decode("QmPewMLNEbdPfBEJ4tBEqJq9i4vbWKHgXTA9Ve2SmXrA2x")
+	checkErr(t, err)
+	checkErr(t, store.put(DealRecord{ProposalCid: p1, Miner: "t0100", EpochPrice: types.NewInt(10)}))
+	checkErr(t, store.put(DealRecord{ProposalCid: p2, Miner: "t0200", EpochPrice: types.NewInt(10)}))
+
+	ch, err := m.Watch(ctx, []cid.Cid{p1, p2}, time.Time{}, WithMiners("t0100"), WithStates(types.DealStaged, types.DealComplete), UntilDone())
+	checkErr(t, err)
+
+	next := func() WatchEvent {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				t.Fatal("watch channel closed early")
+			}
+			return e
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for watch event")
+		}
+		return WatchEvent{}
+	}
+
+	api.setState(p1, "t0100", types.DealAccepted)
+	api.setState(p2, "t0200", types.DealStaged)
+	m.tracker.poll(ctx)
+	api.setState(p1, "t0100", types.DealStaged)
+	m.tracker.poll(ctx)
+	if e := next(); !e.Deal.ProposalCid.Equals(p1) || e.Deal.StateID != types.DealStaged {
+		t.Fatalf("unexpected event %v", e)
+	}
+
+	api.setState(p1, "t0100", types.DealComplete)
+	api.setState(p2, "t0200", types.DealFailed)
+	m.tracker.poll(ctx)
+	if e := next(); !e.Deal.ProposalCid.Equals(p1) || e.Deal.StateID != types.DealComplete {
+		t.Fatalf("unexpected event %v", e)
+	}
+	e := next()
+	if e.Summary == nil || len(e.Summary.Deals) != 2 {
+		t.Fatalf("expected a summary of 2 deals, got %v", e)
+	}
+	if e.Summary.Deals[0].StateID != types.DealComplete || e.Summary.Deals[1].StateID != types.DealFailed {
+		t.Fatalf("unexpected summary %v", e.Summary.Deals)
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("watch channel should be closed after the summary")
+	}
+}
@@ -0,0 +1,312 @@
+package deals
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ipfs/go-cid"
+)
+
+const (
+	// SignatureHeader is the HTTP header with the hex encoded HMAC-SHA256 of
+	// the webhook payload, keyed with the webhook secret
+	SignatureHeader = "X-Signature"
+
+	webhookMaxAttempts = 6
+	webhookTimeout     = time.Second * 10
+)
+
+var (
+	// ErrWebhookNotFound returns when the webhook isn't registered
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	webhookBackoff       = time.Second * 5
+	webhookRetryInterval = time.Second * 5
+)
+
+// Webhook is a URL that receives state changes of a set of proposals, or of
+// every deal created with a wallet address
+type Webhook struct {
+	ID        string
+	URL       string
+	Proposals []cid.Cid
+	Addr      string
+	Secret    []byte
+	CreatedAt time.Time
+}
+
+// Delivery is an attempt to notify a state change to a Webhook
+type Delivery struct {
+	ID          string
+	WebhookID   string
+	Info        DealInfo
+	Attempts    int
+	LastAttempt time.Time
+	Delivered   bool
+	StatusCode  int
+	Error       string
+}
+
+// WebhookPayload is the JSON body POSTed to a Webhook URL
+type WebhookPayload struct {
+	WebhookID  string
+	DeliveryID string
+	Deal       DealInfo
+}
+
+// RegisterWebhook registers rawURL to receive state changes of proposals, and
+// of deals created with wallet addr if it isn't empty. Only proposals created
+// by this Module are tracked, so other ones are rejected. The returned Webhook
+// contains the secret used to sign payloads.
+func (m *Module) RegisterWebhook(rawURL string, proposals []cid.Cid, addr string) (Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Webhook{}, fmt.Errorf("webhook url %q is invalid", rawURL)
+	}
+	if len(proposals) == 0 && addr == "" {
+		return Webhook{}, fmt.Errorf("webhook should watch proposals or a wallet address")
+	}
+	for _, p := range proposals {
+		if _, err := m.store.get(p); err != nil {
+			if err == ErrDealNotFound {
+				return Webhook{}, fmt.Errorf("proposal %s isn't tracked by this server", p)
+			}
+			return Webhook{}, fmt.Errorf("error when getting deal record: %s", err)
+		}
+	}
+	id, err := randomHex(16)
+	if err != nil {
+		return Webhook{}, err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Webhook{}, fmt.Errorf("error when generating webhook secret: %s", err)
+	}
+	wh := Webhook{
+		ID:        id,
+		URL:       rawURL,
+		Proposals: proposals,
+		Addr:      addr,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+	if err := m.store.putWebhook(wh); err != nil {
+		return Webhook{}, fmt.Errorf("error when saving webhook: %s", err)
+	}
+	return wh, nil
+}
+
+// ListWebhooks returns all registered webhooks
+func (m *Module) ListWebhooks() ([]Webhook, error) {
+	return m.store.getAllWebhooks()
+}
+
+// UnregisterWebhook removes a webhook, keeping its delivery log
+func (m *Module) UnregisterWebhook(id string) error {
+	return m.store.deleteWebhook(id)
+}
+
+// ListDeliveries returns the delivery log of a webhook
+func (m *Module) ListDeliveries(id string) ([]Delivery, error) {
+	return m.store.getDeliveries(id)
+}
+
+// dispatchWebhooks is a long running job that creates a Delivery for every
+// state change matching a webhook. Pending deliveries are sent by a separate
+// job, so slow webhooks never block draining sub.
+func (m *Module) dispatchWebhooks(sub *subscription) {
+	defer close(m.webhooksFinished)
+	queued := make(chan struct{}, 1)
+	stop := make(chan struct{})
+	sendFinished := make(chan struct{})
+	go m.sendWebhooks(queued, stop, sendFinished)
+	defer func() {
+		close(stop)
+		<-sendFinished
+	}()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case di, ok := <-sub.ch:
+			if !ok {
+				return
+			}
+			m.queueDeliveries(di)
+			select {
+			case queued <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// sendWebhooks is a long running job that sends pending deliveries when new
+// ones are queued, and periodically to retry failed ones with exponential
+// backoff.
+func (m *Module) sendWebhooks(queued <-chan struct{}, stop <-chan struct{}, finished chan<- struct{}) {
+	defer close(finished)
+	retry := time.NewTicker(webhookRetryInterval)
+	defer retry.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-queued:
+		case <-retry.C:
+		}
+		m.sendPendingDeliveries(m.ctx)
+	}
+}
+
+// queueDeliveries persists a pending Delivery of di for every matching webhook
+func (m *Module) queueDeliveries(di DealInfo) {
+	webhooks, err := m.store.getAllWebhooks()
+	if err != nil {
+		log.Errorf("error when getting webhooks: %s", err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+	var addr string
+	if dr, err := m.store.get(di.ProposalCid); err == nil {
+		addr = dr.Addr
+	}
+	for _, wh := range webhooks {
+		if !wh.matches(di.ProposalCid, addr) {
+			continue
+		}
+		d := Delivery{
+			ID:        fmt.Sprintf("%020d-%s", di.UpdatedAt.UnixNano(), di.ProposalCid),
+			WebhookID: wh.ID,
+			Info:      di,
+		}
+		if err := m.store.putDelivery(d); err != nil {
+			log.Errorf("error when saving delivery for webhook %s: %s", wh.ID, err)
+		}
+	}
+}
+
+// sendPendingDeliveries sends every pending delivery whose backoff elapsed
+func (m *Module) sendPendingDeliveries(ctx context.Context) {
+	deliveries, err := m.store.getPendingDeliveries()
+	if err != nil {
+		log.Errorf("error when getting pending deliveries: %s", err)
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+	all, err := m.store.getAllWebhooks()
+	if err != nil {
+		log.Errorf("error when getting webhooks: %s", err)
+		return
+	}
+	webhooks := make(map[string]Webhook, len(all))
+	for _, wh := range all {
+		webhooks[wh.ID] = wh
+	}
+	for _, d := range deliveries {
+		wh, ok := webhooks[d.WebhookID]
+		if !ok {
+			continue
+		}
+		if d.Attempts > 0 && time.Since(d.LastAttempt) < webhookBackoff<<uint(d.Attempts-1) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		d = m.deliver(ctx, wh, d)
+		if err := m.store.putDelivery(d); err != nil {
+			log.Errorf("error when saving delivery %s: %s", d.ID, err)
+		}
+	}
+}
+
+// deliver POSTs the signed payload of d to the webhook URL, and returns d
+// updated with the result of the attempt
+func (m *Module) deliver(ctx context.Context, wh Webhook, d Delivery) Delivery {
+	d.Attempts++
+	d.LastAttempt = time.Now()
+	d.StatusCode = 0
+	d.Error = ""
+
+	body, err := json.Marshal(&WebhookPayload{WebhookID: wh.ID, DeliveryID: d.ID, Deal: d.Info})
+	if err != nil {
+		d.Error = err.Error()
+		return d
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		d.Error = err.Error()
+		return d
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(wh.Secret, body))
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		d.Error = err.Error()
+		log.Warnf("error when delivering %s to webhook %s: %s", d.ID, wh.ID, err)
+		return d
+	}
+	_ = res.Body.Close()
+	d.StatusCode = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		d.Error = fmt.Sprintf("unexpected status %s", res.Status)
+		log.Warnf("webhook %s answered %s to delivery %s", wh.ID, res.Status, d.ID)
+		return d
+	}
+	d.Delivered = true
+	return d
+}
+
+// pending returns true if d wasn't delivered and has attempts left
+func (d Delivery) pending() bool {
+	return !d.Delivered && d.Attempts < webhookMaxAttempts
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed with secret, as sent
+// in SignatureHeader
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// matches returns true if the webhook watches proposal or deals of addr
+func (wh Webhook) matches(proposal cid.Cid, addr string) bool {
+	if wh.Addr != "" && wh.Addr == addr {
+		return true
+	}
+	for _, p := range wh.Proposals {
+		if p.Equals(proposal) {
+			return true
+		}
+	}
+	return false
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error when generating id: %s", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,136 @@
+package deals
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	m := &Module{store: newDealStore(tests.NewTxMapDatastore())}
+
+	var lock sync.Mutex
+	var payloads []WebhookPayload
+	fail := true
+	var secret []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, err := ioutil.ReadAll(r.Body)
+		checkErr(t, err)
+		if r.Header.Get(SignatureHeader) != Sign(secret, body) {
+			t.Errorf("invalid payload signature")
+		}
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var p WebhookPayload
+		checkErr(t, json.Unmarshal(body, &p))
+		payloads = append(payloads, p)
+	}))
+	defer srv.Close()
+
+	if _, err := m.RegisterWebhook("ftp://host", nil, "t3addr"); err == nil {
+		t.Fatalf("expected invalid url error")
+	}
+	wh, err := m.RegisterWebhook(srv.URL, nil, "t3addr")
+	checkErr(t, err)
+	lock.Lock()
+	secret = wh.Secret
+	lock.Unlock()
+
+	proposal, err := cid.Decode("QmW2dMfxsd3YpS5MSMi5UUTbjMKUckZJjX5ouaQPuCjK8c")
+	checkErr(t, err)
+	other, err := cid.Decode("QmZTR5bcpQD7cFgTorqxZDYaew1Wqgfbd2ud9QqGPAkK2V")
+	checkErr(t, err)
+	checkErr(t, m.store.put(DealRecord{ProposalCid: proposal, Addr: "t3addr"}))
+	if _, err := m.RegisterWebhook(srv.URL, []cid.Cid{other}, ""); err == nil {
+		t.Fatalf("expected error for a proposal that isn't tracked")
+	}
+	wh2, err := m.RegisterWebhook(srv.URL, []cid.Cid{proposal}, "")
+	checkErr(t, err)
+	checkErr(t, m.UnregisterWebhook(wh2.ID))
+	m.queueDeliveries(DealInfo{ProposalCid: proposal, StateID: types.DealAccepted, UpdatedAt: time.Now()})
+	m.queueDeliveries(DealInfo{ProposalCid: other, StateID: types.DealAccepted, UpdatedAt: time.Now()})
+
+	m.sendPendingDeliveries(ctx)
+	deliveries, err := m.ListDeliveries(wh.ID)
+	checkErr(t, err)
+	if len(deliveries) != 1 {
+		t.Fatalf("expected 1 delivery, got %d", len(deliveries))
+	}
+	d := deliveries[0]
+	if d.Delivered || d.Attempts != 1 || d.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("unexpected failed delivery %v", d)
+	}
+
+	d = m.deliver(ctx, wh, d)
+	if !d.Delivered || d.Attempts != 2 {
+		t.Fatalf("unexpected successful delivery %v", d)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if len(payloads) != 1 || payloads[0].Deal.ProposalCid != proposal || payloads[0].WebhookID != wh.ID {
+		t.Fatalf("unexpected payloads %v", payloads)
+	}
+}
+
+func TestDispatchWebhooksSlowURL(t *testing.T) {
+	t.Parallel()
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-block:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Module{
+		store:            newDealStore(tests.NewTxMapDatastore()),
+		ctx:              ctx,
+		cancel:           cancel,
+		webhooksFinished: make(chan struct{}),
+	}
+	wh, err := m.RegisterWebhook(srv.URL, nil, "t3addr")
+	checkErr(t, err)
+	sub := &subscription{all: true, ch: make(chan DealInfo)}
+	go m.dispatchWebhooks(sub)
+
+	for i := 0; i < 5; i++ {
+		proposal := genTestCid(t, fmt.Sprintf("proposal-%d", i))
+		checkErr(t, m.store.put(DealRecord{ProposalCid: proposal, Addr: "t3addr"}))
+		select {
+		case sub.ch <- DealInfo{ProposalCid: proposal, StateID: types.DealAccepted, UpdatedAt: time.Now()}:
+		case <-time.After(time.Second):
+			t.Fatalf("subscription isn't drained while a delivery is in flight")
+		}
+	}
+	cancel()
+	select {
+	case <-m.webhooksFinished:
+	case <-time.After(time.Second * 3):
+		t.Fatalf("webhook dispatching didn't stop")
+	}
+	deliveries, err := m.ListDeliveries(wh.ID)
+	checkErr(t, err)
+	if len(deliveries) != 5 {
+		t.Fatalf("expected 5 queued deliveries, got %d", len(deliveries))
+	}
+}
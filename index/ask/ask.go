@@ -1,9 +1,11 @@
 package ask
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	cbor "github.com/ipfs/go-ipld-cbor"
 	logging "github.com/ipfs/go-log"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/textileio/filecoin/index/miner"
 	"github.com/textileio/filecoin/lotus/types"
 	"github.com/textileio/filecoin/signaler"
 	"go.opencensus.io/stats"
@@ -18,24 +21,30 @@ import (
 )
 
 var (
-	qaRatelim         = 100
-	qaTimeout         = time.Second * 10
-	qaRefreshInterval = time.Minute
-	dsIndex           = datastore.NewKey("index")
+	qaRatelim          = 100
+	qaTimeout          = time.Second * 10
+	qaRefreshInterval  = time.Minute
+	qaMaxBackoff       = time.Hour * 6
+	qaScheduleInterval = time.Second * 15
+	dsIndex            = datastore.NewKey("index")
 
 	log = logging.Logger("index-ask")
 )
 
 // AskIndex contains cached information about markets
 type AskIndex struct {
-	api      API
-	ds       datastore.TxnDatastore
-	signaler *signaler.Signaler
+	api        API
+	minerIndex MinerIndex
+	ds         datastore.TxnDatastore
+	signaler   *signaler.Signaler
 
 	lock              sync.Mutex
 	index             Index
 	priceOrderedCache []*StorageAsk
 
+	schedule  map[string]minerSchedule
+	listeners map[chan []AskChange]struct{}
+
 	ctx      context.Context
 	cancel   context.CancelFunc
 	finished chan struct{}
@@ -48,20 +57,31 @@ type API interface {
 	StateListMiners(context.Context, *types.TipSet) ([]string, error)
 	ClientQueryAsk(ctx context.Context, p peer.ID, miner string) (*types.SignedStorageAsk, error)
 	StateMinerPeerID(ctx context.Context, m string, ts *types.TipSet) (peer.ID, error)
+	ChainHead(context.Context) (*types.TipSet, error)
+	StateMinerWorker(ctx context.Context, m string, ts *types.TipSet) (string, error)
+	WalletVerify(ctx context.Context, k string, msg []byte, sig *types.Signature) (bool, error)
+}
+
+// MinerIndex provides power and location information of miners
+type MinerIndex interface {
+	Get() miner.Index
 }
 
 // New returnas a new AskIndex. It loads saved information from ds, and immeediatelly
 // starts keeping the cache up to date.
-func New(ds datastore.TxnDatastore, api API) (*AskIndex, error) {
+func New(ds datastore.TxnDatastore, api API, mi MinerIndex) (*AskIndex, error) {
 	initMetrics()
 	ctx, cancel := context.WithCancel(context.Background())
 	ai := &AskIndex{
-		signaler: signaler.New(),
-		api:      api,
-		ds:       ds,
-		ctx:      ctx,
-		cancel:   cancel,
-		finished: make(chan struct{}),
+		signaler:   signaler.New(),
+		api:        api,
+		minerIndex: mi,
+		ds:         ds,
+		schedule:   make(map[string]minerSchedule),
+		listeners:  make(map[chan []AskChange]struct{}),
+		ctx:        ctx,
+		cancel:     cancel,
+		finished:   make(chan struct{}),
 	}
 	if err := ai.loadFromStore(); err != nil {
 		return nil, err
@@ -76,6 +96,7 @@ func (ai *AskIndex) Get() Index {
 	defer ai.lock.Unlock()
 	index := Index{
 		LastUpdated:        ai.index.LastUpdated,
+		Height:             ai.index.Height,
 		StorageMedianPrice: ai.index.StorageMedianPrice,
 		Storage:            make(map[string]StorageAsk, len(ai.index.Storage)),
 	}
@@ -87,10 +108,29 @@ func (ai *AskIndex) Get() Index {
 
 // Query executes a query to retrieve active Asks
 func (ai *AskIndex) Query(q Query) ([]StorageAsk, error) {
+	var height uint64
+	if q.NotExpired {
+		ctx, cancel := context.WithTimeout(ai.ctx, qaTimeout)
+		defer cancel()
+		head, err := ai.api.ChainHead(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error when getting current height: %s", err)
+		}
+		height = head.Height
+	}
+	var mi miner.Index
+	if q.MinPower > 0 || len(q.Countries) > 0 || q.SortBy == SortByPower {
+		if ai.minerIndex == nil {
+			return nil, fmt.Errorf("miner information isn't available")
+		}
+		mi = ai.minerIndex.Get()
+	}
+	excluded := toSet(q.ExcludedMiners, false)
+	included := toSet(q.IncludedMiners, false)
+	countries := toSet(q.Countries, true)
+
 	ai.lock.Lock()
-	defer ai.lock.Unlock()
 	var res []StorageAsk
-	offset := q.Offset
 	for _, sa := range ai.priceOrderedCache {
 		if q.MaxPrice != 0 && sa.Price > q.MaxPrice {
 			break
@@ -98,14 +138,55 @@ func (ai *AskIndex) Query(q Query) ([]StorageAsk, error) {
 		if q.PieceSize != 0 && sa.MinPieceSize > q.PieceSize {
 			continue
 		}
-		if offset > 0 {
-			offset--
+		if _, ok := excluded[sa.Miner]; ok {
 			continue
 		}
-		res = append(res, *sa)
-		if q.Limit != 0 && len(res) == q.Limit {
-			break
+		if _, ok := included[sa.Miner]; len(included) > 0 && !ok {
+			continue
+		}
+		if q.NotExpired && sa.Expiry <= height {
+			continue
+		}
+		if q.OnlyVerified && !sa.Verified {
+			continue
+		}
+		if q.MinPower > 0 && mi.Chain.Power[sa.Miner].Power < q.MinPower {
+			continue
+		}
+		if _, ok := countries[strings.ToUpper(mi.Meta.Info[sa.Miner].Location.Country)]; len(countries) > 0 && !ok {
+			continue
 		}
+		res = append(res, *sa)
+	}
+	ai.lock.Unlock()
+
+	switch q.SortBy {
+	case SortByPrice:
+	case SortByPower:
+		sort.SliceStable(res, func(i, j int) bool {
+			return mi.Chain.Power[res[i].Miner].Power > mi.Chain.Power[res[j].Miner].Power
+		})
+	case SortByFreshness:
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].Timestamp > res[j].Timestamp
+		})
+	default:
+		return nil, fmt.Errorf("unknown sort order %d", q.SortBy)
+	}
+
+	// negative values don't bound the results
+	if q.Offset < 0 {
+		q.Offset = 0
+	}
+	if q.Limit < 0 {
+		q.Limit = 0
+	}
+	if q.Offset >= len(res) {
+		return nil, nil
+	}
+	res = res[q.Offset:]
+	if q.Limit != 0 && len(res) > q.Limit {
+		res = res[:q.Limit]
 	}
 	return res, nil
 }
@@ -147,7 +228,7 @@ func (ai *AskIndex) start() {
 		case <-ai.ctx.Done():
 			log.Info("graceful shutdown of ask index background job")
 			return
-		case <-time.After(qaRefreshInterval):
+		case <-time.After(qaScheduleInterval):
 			if err := ai.update(); err != nil {
 				log.Errorf("error when updating miners asks: %s", err)
 			}
@@ -155,15 +236,33 @@ func (ai *AskIndex) start() {
 	}
 }
 
-// update triggers a full-scan generates and saves a new fresh index and builds
-// views for better querying.
+// update queries the asks of miners due for a refresh, merges them into the
+// current index, saves it and builds views for better querying.
 func (ai *AskIndex) update() error {
 	log.Info("updating ask index...")
 	startTime := time.Now()
-	newIndex, err := generateIndex(ai.ctx, ai.api)
+	head, err := ai.api.ChainHead(ai.ctx)
 	if err != nil {
 		return err
 	}
+	addrs, err := ai.api.StateListMiners(ai.ctx, head)
+	if err != nil {
+		return err
+	}
+	due := ai.dueMiners(addrs, startTime)
+	asks := queryAsks(ai.ctx, ai.api, due)
+	select {
+	case <-ai.ctx.Done():
+		return fmt.Errorf("refresh was cancelled")
+	default:
+	}
+	ai.reschedule(due, asks, time.Now())
+
+	ai.lock.Lock()
+	oldIndex := ai.index
+	newIndex := mergeIndex(oldIndex, addrs, asks)
+	ai.lock.Unlock()
+	newIndex.Height = head.Height
 
 	buf, err := cbor.DumpObject(newIndex)
 	if err != nil {
@@ -172,32 +271,78 @@ func (ai *AskIndex) update() error {
 	if err = ai.ds.Put(dsIndex, buf); err != nil {
 		return err
 	}
-
-	cache := make([]*StorageAsk, 0, len(ai.index.Storage))
-	for _, v := range ai.index.Storage {
-		cache = append(cache, &v)
+	if err := ai.appendHistory(asks, newIndex.LastUpdated); err != nil {
+		return fmt.Errorf("error when saving price history: %s", err)
 	}
-	sort.Slice(cache, func(i, j int) bool {
-		return cache[i].Price < cache[j].Price
-	})
 
+	cache := buildPriceOrderedCache(newIndex.Storage)
 	ai.lock.Lock()
-	ai.index = *newIndex
+	ai.index = newIndex
 	ai.priceOrderedCache = cache
 	ai.lock.Unlock()
+	ai.publishChanges(diffIndex(oldIndex, newIndex))
+	ai.signaler.Signal()
 
 	stats.Record(context.Background(), mFullRefreshDuration.M(time.Since(startTime).Milliseconds()))
 
 	return nil
 }
 
-// generateIndex returns a fresh index
-func generateIndex(ctx context.Context, api API) (*Index, error) {
-	addrs, err := api.StateListMiners(ctx, nil)
+// verifyAsk returns true if the ask is signed by the worker key of miner addr
+func verifyAsk(ctx context.Context, api API, addr string, sa *types.SignedStorageAsk) (bool, error) {
+	if sa.Ask == nil || sa.Signature == nil {
+		return false, nil
+	}
+	if sa.Ask.Miner != addr {
+		return false, fmt.Errorf("ask is for miner %s", sa.Ask.Miner)
+	}
+	if sa.Signature.Type != types.KTBLS && sa.Signature.Type != types.KTSecp256k1 {
+		return false, fmt.Errorf("unsupported signature type %q", sa.Signature.Type)
+	}
+	var buf bytes.Buffer
+	if err := sa.Ask.MarshalCBOR(&buf); err != nil {
+		return false, fmt.Errorf("error when serializing ask: %s", err)
+	}
+	worker, err := api.StateMinerWorker(ctx, addr, nil)
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+	return api.WalletVerify(ctx, worker, buf.Bytes(), sa.Signature)
+}
+
+// mergeIndex returns a new index with the asks of index updated with asks.
+// Asks of miners that aren't in addrs anymore are removed.
+func mergeIndex(index Index, addrs []string, asks map[string]StorageAsk) Index {
+	storage := make(map[string]StorageAsk, len(addrs))
+	for _, addr := range addrs {
+		if sa, ok := asks[addr]; ok {
+			storage[addr] = sa
+		} else if sa, ok := index.Storage[addr]; ok {
+			storage[addr] = sa
+		}
+	}
+	return Index{
+		LastUpdated:        time.Now(),
+		StorageMedianPrice: calculateMedian(storage),
+		Storage:            storage,
 	}
+}
+
+// buildPriceOrderedCache returns the asks of storage sorted by price
+func buildPriceOrderedCache(storage map[string]StorageAsk) []*StorageAsk {
+	cache := make([]*StorageAsk, 0, len(storage))
+	for _, v := range storage {
+		v := v
+		cache = append(cache, &v)
+	}
+	sort.Slice(cache, func(i, j int) bool {
+		return cache[i].Price < cache[j].Price
+	})
+	return cache
+}
 
+// queryAsks queries the asks of addrs, returning the ones that answered
+func queryAsks(ctx context.Context, api API, addrs []string) map[string]StorageAsk {
 	rateLim := make(chan struct{}, qaRatelim)
 	var lock sync.Mutex
 	newAsks := make(map[string]StorageAsk)
@@ -209,14 +354,18 @@ func generateIndex(ctx context.Context, api API) (*Index, error) {
 			defer cancel()
 			pid, err := api.StateMinerPeerID(ictx, addr, nil)
 			if err != nil {
-				log.Debug("error getting pid of %s: %s", addr, err)
+				log.Debugf("error getting pid of %s: %s", addr, err)
 				return
 			}
 			ask, err := api.ClientQueryAsk(ictx, pid, addr)
 			if err != nil {
-				log.Debug("error query-asking miner: %s", err)
+				log.Debugf("error query-asking miner: %s", err)
 				return
 			}
+			verified, err := verifyAsk(ictx, api, addr, ask)
+			if err != nil {
+				log.Debugf("error verifying ask of %s: %s", addr, err)
+			}
 			lock.Lock()
 			newAsks[addr] = StorageAsk{
 				Miner:        ask.Ask.Miner,
@@ -224,45 +373,40 @@ func generateIndex(ctx context.Context, api API) (*Index, error) {
 				MinPieceSize: ask.Ask.MinPieceSize,
 				Timestamp:    ask.Ask.Timestamp,
 				Expiry:       ask.Ask.Expiry,
+				Verified:     verified,
 			}
 			lock.Unlock()
 		}(addr)
 		if i%100 == 0 {
 			stats.Record(context.Background(), mFullRefreshProgress.M(float64(i)/float64(len(addrs))))
-			log.Debug("progress %d/%d", i, len(addrs))
+			log.Debugf("progress %d/%d", i, len(addrs))
 		}
 	}
 	for i := 0; i < qaRatelim; i++ {
 		rateLim <- struct{}{}
 	}
 
-	select {
-	case <-ctx.Done():
-		return nil, fmt.Errorf("refresh was cancelled")
-	default:
-	}
-
 	stats.Record(context.Background(), mFullRefreshProgress.M(1))
 	ctx, _ = tag.New(context.Background(), tag.Insert(keyAskStatus, "FAIL"))
 	stats.Record(ctx, mAskQueryResult.M(int64(len(addrs)-len(newAsks))))
 	ctx, _ = tag.New(context.Background(), tag.Insert(keyAskStatus, "OK"))
 	stats.Record(ctx, mAskQueryResult.M(int64(len(newAsks))))
 
-	return &Index{
-		LastUpdated:        time.Now(),
-		StorageMedianPrice: calculateMedian(newAsks),
-		Storage:            newAsks,
-	}, nil
+	return newAsks
 }
 
 func calculateMedian(index map[string]StorageAsk) uint64 {
-	if len(index) == 0 {
-		return 0
-	}
 	prices := make([]uint64, 0, len(index))
 	for _, v := range index {
 		prices = append(prices, v.Price)
 	}
+	return median(prices)
+}
+
+func median(prices []uint64) uint64 {
+	if len(prices) == 0 {
+		return 0
+	}
 	sort.Slice(prices, func(i, j int) bool {
 		return prices[i] < prices[j]
 	})
@@ -276,6 +420,18 @@ func calculateMedian(index map[string]StorageAsk) uint64 {
 	return (prices[len/2-1] + prices[len/2]) / 2
 }
 
+// toSet returns a set with the values of l, uppercased if upper is true
+func toSet(l []string, upper bool) map[string]struct{} {
+	set := make(map[string]struct{}, len(l))
+	for _, v := range l {
+		if upper {
+			v = strings.ToUpper(v)
+		}
+		set[v] = struct{}{}
+	}
+	return set
+}
+
 func (ai *AskIndex) loadFromStore() error {
 	buf, err := ai.ds.Get(dsIndex)
 	if err != nil {
@@ -288,5 +444,6 @@ func (ai *AskIndex) loadFromStore() error {
 	if err = cbor.DecodeInto(buf, &ai.index); err != nil {
 		return err
 	}
+	ai.priceOrderedCache = buildPriceOrderedCache(ai.index.Storage)
 	return nil
 }
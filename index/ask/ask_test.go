@@ -1,11 +1,21 @@
 package ask
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/textileio/filecoin/index/miner"
 	"github.com/textileio/filecoin/lotus"
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/signaler"
 	"github.com/textileio/filecoin/tests"
 )
 
@@ -20,9 +30,10 @@ func TestFreshBuild(t *testing.T) {
 	defer cls()
 
 	qaRatelim = 2000
-	index, err := generateIndex(ctx, api)
+	addrs, err := api.StateListMiners(ctx, nil)
 	checkErr(t, err)
-	if len(index.Storage) == 0 {
+	asks := queryAsks(ctx, api, addrs)
+	if len(asks) == 0 {
 		t.Fatalf("current asks can't be empty")
 	}
 }
@@ -54,6 +65,8 @@ func TestQueryAsk(t *testing.T) {
 		{name: "LeqPrice50", q: Query{MaxPrice: 50}, expect: facr},
 		{name: "LeqPrice40Piece96", q: Query{MaxPrice: 35, PieceSize: 96}, expect: []StorageAsk{facr[1]}},
 		{name: "AllLimit2Offset1", q: Query{Limit: 2, Offset: 1}, expect: []StorageAsk{facr[1], facr[2]}},
+		{name: "NegativeOffset", q: Query{Limit: 1, Offset: -1}, expect: []StorageAsk{facr[0]}},
+		{name: "NegativeLimit", q: Query{Limit: -1}, expect: facr},
 	}
 
 	for _, tt := range tests {
@@ -71,9 +84,263 @@ func TestQueryAsk(t *testing.T) {
 	}
 }
 
+func TestQueryAskFilters(t *testing.T) {
+	t.Parallel()
+	dm := AskIndex{
+		ctx: context.Background(),
+		api: &mockAPI{height: 100},
+		minerIndex: &mockMinerIndex{index: miner.Index{
+			Chain: miner.ChainIndex{Power: map[string]miner.Power{
+				"t01": {Power: 10},
+				"t02": {Power: 40},
+				"t03": {Power: 30},
+			}},
+			Meta: miner.MetaIndex{Info: map[string]miner.Meta{
+				"t01": {Location: miner.Location{Country: "AR"}},
+				"t02": {Location: miner.Location{Country: "US"}},
+				"t03": {Location: miner.Location{Country: "ar"}},
+			}},
+		}},
+	}
+	dm.priceOrderedCache = []*StorageAsk{
+		{Price: 20, Miner: "t01", Timestamp: 5, Expiry: 200, Verified: true},
+		{Price: 30, Miner: "t02", Timestamp: 7, Expiry: 50},
+		{Price: 40, Miner: "t03", Timestamp: 6, Expiry: 300},
+	}
+	asks := make([]StorageAsk, len(dm.priceOrderedCache))
+	for i, sa := range dm.priceOrderedCache {
+		asks[i] = *sa
+	}
+
+	tests := []struct {
+		name   string
+		q      Query
+		expect []StorageAsk
+	}{
+		{name: "Excluded", q: Query{ExcludedMiners: []string{"t02"}}, expect: []StorageAsk{asks[0], asks[2]}},
+		{name: "Included", q: Query{IncludedMiners: []string{"t02", "t03"}}, expect: []StorageAsk{asks[1], asks[2]}},
+		{name: "NotExpired", q: Query{NotExpired: true}, expect: []StorageAsk{asks[0], asks[2]}},
+		{name: "MinPower", q: Query{MinPower: 30}, expect: []StorageAsk{asks[1], asks[2]}},
+		{name: "Country", q: Query{Countries: []string{"ar"}}, expect: []StorageAsk{asks[0], asks[2]}},
+		{name: "OnlyVerified", q: Query{OnlyVerified: true}, expect: []StorageAsk{asks[0]}},
+		{name: "SortByPower", q: Query{SortBy: SortByPower}, expect: []StorageAsk{asks[1], asks[2], asks[0]}},
+		{name: "SortByFreshness", q: Query{SortBy: SortByFreshness}, expect: []StorageAsk{asks[1], asks[2], asks[0]}},
+		{name: "SortByPowerLimit1Offset1", q: Query{SortBy: SortByPower, Limit: 1, Offset: 1}, expect: []StorageAsk{asks[2]}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := dm.Query(tt.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.expect, got) {
+				t.Fatalf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestIncrementalUpdate(t *testing.T) {
+	t.Parallel()
+	api := &mockAPI{
+		miners: []string{"t01", "t02"},
+		asks:   map[string]uint64{"t01": 10},
+	}
+	ai := &AskIndex{
+		api:       api,
+		ds:        tests.NewTxMapDatastore(),
+		index:     Index{Storage: make(map[string]StorageAsk)},
+		schedule:  make(map[string]minerSchedule),
+		listeners: make(map[chan []AskChange]struct{}),
+		signaler:  signaler.New(),
+		ctx:       context.Background(),
+	}
+	checkErr(t, ai.update())
+	if len(ai.Get().Storage) != 1 || ai.Get().Storage["t01"].Price != 10 || !ai.Get().Storage["t01"].Verified {
+		t.Fatalf("unexpected index %v", ai.Get())
+	}
+	if ai.schedule["t01"].Failures != 0 || ai.schedule["t02"].Failures != 1 {
+		t.Fatalf("unexpected schedule %v", ai.schedule)
+	}
+	if !ai.schedule["t02"].NextQuery.After(ai.schedule["t01"].NextQuery) {
+		t.Fatalf("unresponsive miner should be backed off")
+	}
+	if due := ai.dueMiners(api.miners, time.Now()); len(due) != 0 {
+		t.Fatalf("no miner should be due, got %v", due)
+	}
+	if due := ai.dueMiners(api.miners, time.Now().Add(qaRefreshInterval)); len(due) != 1 || due[0] != "t01" {
+		t.Fatalf("only the responsive miner should be due, got %v", due)
+	}
+
+	// A failure of a known miner keeps its last ask
+	api.setAsks(map[string]uint64{"t02": 20})
+	for addr := range ai.schedule {
+		ai.schedule[addr] = minerSchedule{}
+	}
+	checkErr(t, ai.update())
+	index := ai.Get()
+	if len(index.Storage) != 2 || index.Storage["t01"].Price != 10 || index.Storage["t02"].Price != 20 {
+		t.Fatalf("unexpected merged index %v", index)
+	}
+	if got, err := ai.Query(Query{}); err != nil || len(got) != 2 || got[0].Miner != "t01" {
+		t.Fatalf("unexpected query result %v, %v", got, err)
+	}
+
+	// Miners that aren't listed anymore are removed
+	api.miners = []string{"t02"}
+	checkErr(t, ai.update())
+	if _, ok := ai.Get().Storage["t01"]; ok {
+		t.Fatalf("unlisted miner should be removed")
+	}
+}
+
+func TestVerifyAsk(t *testing.T) {
+	t.Parallel()
+	api := &mockAPI{asks: map[string]uint64{"t01": 10}}
+	sa, err := api.ClientQueryAsk(context.Background(), peer.ID(""), "t01")
+	checkErr(t, err)
+
+	verified, err := verifyAsk(context.Background(), api, "t01", sa)
+	checkErr(t, err)
+	if !verified {
+		t.Fatalf("signed ask should be verified")
+	}
+	if verified, err := verifyAsk(context.Background(), api, "t02", sa); err == nil || verified {
+		t.Fatalf("ask of another miner shouldn't be verified")
+	}
+	sa.Ask.Price = types.NewInt(5)
+	verified, err = verifyAsk(context.Background(), api, "t01", sa)
+	checkErr(t, err)
+	if verified {
+		t.Fatalf("tampered ask shouldn't be verified")
+	}
+	sa.Signature = nil
+	if verified, _ := verifyAsk(context.Background(), api, "t01", sa); verified {
+		t.Fatalf("unsigned ask shouldn't be verified")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	if backoff(0) != qaRefreshInterval || backoff(2) != qaRefreshInterval*4 {
+		t.Fatalf("unexpected backoff %v, %v", backoff(0), backoff(2))
+	}
+	if backoff(100) != qaMaxBackoff {
+		t.Fatalf("backoff should be capped, got %v", backoff(100))
+	}
+}
+
+type mockAPI struct {
+	height uint64
+	miners []string
+
+	lock sync.Mutex
+	asks map[string]uint64
+}
+
+func (m *mockAPI) setAsks(asks map[string]uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.asks = asks
+}
+
+func (m *mockAPI) StateListMiners(ctx context.Context, ts *types.TipSet) ([]string, error) {
+	return m.miners, nil
+}
+
+func (m *mockAPI) StateMinerPeerID(ctx context.Context, addr string, ts *types.TipSet) (peer.ID, error) {
+	return peer.ID(addr), nil
+}
+
+func (m *mockAPI) ClientQueryAsk(ctx context.Context, p peer.ID, addr string) (*types.SignedStorageAsk, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	price, ok := m.asks[addr]
+	if !ok {
+		return nil, fmt.Errorf("miner %s is unresponsive", addr)
+	}
+	sa := &types.StorageAsk{Miner: addr, Price: types.NewInt(price)}
+	var buf bytes.Buffer
+	if err := sa.MarshalCBOR(&buf); err != nil {
+		return nil, err
+	}
+	return &types.SignedStorageAsk{Ask: sa, Signature: &types.Signature{Type: types.KTBLS, Data: buf.Bytes()}}, nil
+}
+
+func (m *mockAPI) StateMinerWorker(ctx context.Context, addr string, ts *types.TipSet) (string, error) {
+	return "worker-" + addr, nil
+}
+
+// WalletVerify accepts signatures of workers that contain the signed message
+func (m *mockAPI) WalletVerify(ctx context.Context, k string, msg []byte, sig *types.Signature) (bool, error) {
+	return strings.HasPrefix(k, "worker-") && bytes.Equal(msg, sig.Data), nil
+}
+
+func (m *mockAPI) ChainHead(ctx context.Context) (*types.TipSet, error) {
+	return &types.TipSet{Height: m.height}, nil
+}
+
+type mockMinerIndex struct {
+	index miner.Index
+}
+
+func (m *mockMinerIndex) Get() miner.Index {
+	return m.index
+}
+
 func checkErr(t *testing.T, err error) {
 	t.Helper()
 	if err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	ai := AskIndex{ds: tests.NewTxMapDatastore()}
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	refreshes := []struct {
+		at     time.Time
+		prices map[string]uint64
+	}{
+		{at: day.Add(time.Hour), prices: map[string]uint64{"t01": 10, "t010": 100}},
+		{at: day.Add(time.Hour * 2), prices: map[string]uint64{"t01": 20, "t010": 100}},
+		{at: day.Add(time.Hour * 26), prices: map[string]uint64{"t01": 30}},
+	}
+	for _, r := range refreshes {
+		asks := make(map[string]StorageAsk)
+		for miner, price := range r.prices {
+			asks[miner] = StorageAsk{Miner: miner, Price: price}
+		}
+		checkErr(t, ai.appendHistory(asks, r.at))
+	}
+
+	h, err := ai.History("t01", time.Time{}, time.Time{})
+	checkErr(t, err)
+	if len(h.Points) != 3 || h.Points[0].Price != 10 || h.Points[2].Price != 30 {
+		t.Fatalf("unexpected points %v", h.Points)
+	}
+	expMedians := []DailyPrice{{Day: day, Median: 15}, {Day: day.Add(time.Hour * 24), Median: 30}}
+	if len(h.DailyMedians) != 2 || !h.DailyMedians[0].Day.Equal(expMedians[0].Day) ||
+		h.DailyMedians[0].Median != 15 || h.DailyMedians[1].Median != 30 {
+		t.Fatalf("expected daily medians %v, got %v", expMedians, h.DailyMedians)
+	}
+	if math.Abs(h.Volatility-math.Sqrt(200.0/3)/20) > 1e-9 {
+		t.Fatalf("unexpected volatility %f", h.Volatility)
+	}
+
+	h, err = ai.History("t01", day.Add(time.Hour*2), day.Add(time.Hour*3))
+	checkErr(t, err)
+	if len(h.Points) != 1 || h.Points[0].Price != 20 {
+		t.Fatalf("unexpected points in range %v", h.Points)
+	}
+
+	h, err = ai.History("", time.Time{}, time.Time{})
+	checkErr(t, err)
+	if len(h.Points) != 5 {
+		t.Fatalf("expected 5 points of all miners, got %d", len(h.Points))
+	}
+}
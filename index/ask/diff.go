@@ -0,0 +1,115 @@
+package ask
+
+import (
+	"context"
+	"sort"
+)
+
+var (
+	changesBuffer = 10
+)
+
+// ChangeType indicates how an ask changed between two index snapshots
+type ChangeType int
+
+const (
+	// AskNew is a miner ask that wasn't in the index
+	AskNew ChangeType = iota
+	// AskPriceChanged is an ask with a different price
+	AskPriceChanged
+	// AskExpired is an ask whose expiry was reached
+	AskExpired
+	// AskRemoved is an ask that isn't in the index anymore
+	AskRemoved
+)
+
+var changeTypeStrings = map[ChangeType]string{
+	AskNew:          "New",
+	AskPriceChanged: "PriceChanged",
+	AskExpired:      "Expired",
+	AskRemoved:      "Removed",
+}
+
+func (ct ChangeType) String() string {
+	return changeTypeStrings[ct]
+}
+
+// AskChange is a change of a miner ask between two index snapshots. Ask is
+// the previous ask if it was removed, and the current one otherwise.
+type AskChange struct {
+	Type     ChangeType
+	Ask      StorageAsk
+	OldPrice uint64
+}
+
+// Changes returns a channel that receives the ask changes of every index
+// update. The channel is closed when ctx is done or the AskIndex is closed.
+// Updates are dropped if the channel isn't drained.
+func (ai *AskIndex) Changes(ctx context.Context) <-chan []AskChange {
+	ch := make(chan []AskChange, changesBuffer)
+	ai.lock.Lock()
+	ai.listeners[ch] = struct{}{}
+	ai.lock.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-ai.ctx.Done():
+		}
+		ai.lock.Lock()
+		delete(ai.listeners, ch)
+		close(ch)
+		ai.lock.Unlock()
+	}()
+	return ch
+}
+
+// publishChanges sends changes to all listeners without blocking
+func (ai *AskIndex) publishChanges(changes []AskChange) {
+	if len(changes) == 0 {
+		return
+	}
+	ai.lock.Lock()
+	defer ai.lock.Unlock()
+	for ch := range ai.listeners {
+		select {
+		case ch <- changes:
+		default:
+			log.Warnf("dropping %d ask changes for slow listener", len(changes))
+		}
+	}
+}
+
+// diffIndex returns the changes from old to new, sorted by miner. An ask is
+// expired when its expiry is reached by the height of the new index, and it
+// wasn't already by the height of the old one.
+func diffIndex(old, new Index) []AskChange {
+	var changes []AskChange
+	for addr, sa := range new.Storage {
+		prev, ok := old.Storage[addr]
+		switch {
+		case !ok:
+			changes = append(changes, AskChange{Type: AskNew, Ask: sa})
+		case sa.Price != prev.Price:
+			changes = append(changes, AskChange{Type: AskPriceChanged, Ask: sa, OldPrice: prev.Price})
+		}
+		if ok && expired(sa, new.Height) && !expired(prev, old.Height) {
+			changes = append(changes, AskChange{Type: AskExpired, Ask: sa})
+		}
+	}
+	for addr, sa := range old.Storage {
+		if _, ok := new.Storage[addr]; !ok {
+			changes = append(changes, AskChange{Type: AskRemoved, Ask: sa})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Ask.Miner != changes[j].Ask.Miner {
+			return changes[i].Ask.Miner < changes[j].Ask.Miner
+		}
+		return changes[i].Type < changes[j].Type
+	})
+	return changes
+}
+
+func expired(sa StorageAsk, height uint64) bool {
+	return height > 0 && sa.Expiry <= height
+}
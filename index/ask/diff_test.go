@@ -0,0 +1,88 @@
+package ask
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/textileio/filecoin/signaler"
+	"github.com/textileio/filecoin/tests"
+)
+
+func TestDiffIndex(t *testing.T) {
+	t.Parallel()
+	old := Index{Height: 100, Storage: map[string]StorageAsk{
+		"t01": {Miner: "t01", Price: 10, Expiry: 200},
+		"t02": {Miner: "t02", Price: 20, Expiry: 150},
+		"t03": {Miner: "t03", Price: 30, Expiry: 90},
+		"t04": {Miner: "t04", Price: 40, Expiry: 300},
+	}}
+	new := Index{Height: 160, Storage: map[string]StorageAsk{
+		"t01": {Miner: "t01", Price: 10, Expiry: 200},
+		"t02": {Miner: "t02", Price: 25, Expiry: 150},
+		"t03": {Miner: "t03", Price: 30, Expiry: 90},
+		"t05": {Miner: "t05", Price: 50, Expiry: 300},
+	}}
+	expected := []AskChange{
+		{Type: AskPriceChanged, Ask: new.Storage["t02"], OldPrice: 20},
+		{Type: AskExpired, Ask: new.Storage["t02"]},
+		{Type: AskRemoved, Ask: old.Storage["t04"]},
+		{Type: AskNew, Ask: new.Storage["t05"]},
+	}
+	got := diffIndex(old, new)
+	if !reflect.DeepEqual(expected, got) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	if changes := diffIndex(new, new); len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	t.Parallel()
+	api := &mockAPI{
+		height: 10,
+		miners: []string{"t01"},
+		asks:   map[string]uint64{"t01": 10},
+	}
+	ai := &AskIndex{
+		api:       api,
+		ds:        tests.NewTxMapDatastore(),
+		index:     Index{Storage: make(map[string]StorageAsk)},
+		schedule:  make(map[string]minerSchedule),
+		listeners: make(map[chan []AskChange]struct{}),
+		signaler:  signaler.New(),
+		ctx:       context.Background(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ai.Changes(ctx)
+	checkErr(t, ai.update())
+	select {
+	case changes := <-ch:
+		if len(changes) != 1 || changes[0].Type != AskNew || changes[0].Ask.Miner != "t01" {
+			t.Fatalf("unexpected changes %v", changes)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected ask changes")
+	}
+
+	// An update without changes isn't published
+	ai.schedule["t01"] = minerSchedule{}
+	checkErr(t, ai.update())
+	select {
+	case changes := <-ch:
+		t.Fatalf("unexpected changes %v", changes)
+	default:
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("channel should be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel should be closed when the context is done")
+	}
+}
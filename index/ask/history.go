@@ -0,0 +1,148 @@
+package ask
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	cbor "github.com/ipfs/go-ipld-cbor"
+)
+
+var (
+	dsHistory = datastore.NewKey("history")
+)
+
+// History returns the prices of miner asks observed between from and to. If
+// miner is empty, prices of all miners are considered. Zero times don't bound
+// the range.
+func (ai *AskIndex) History(miner string, from, to time.Time) (PriceHistory, error) {
+	points, err := ai.getHistory(miner, from, to)
+	if err != nil {
+		return PriceHistory{}, fmt.Errorf("error when getting price history: %s", err)
+	}
+	return PriceHistory{
+		Points:       points,
+		DailyMedians: dailyMedians(points),
+		Volatility:   volatility(points),
+	}, nil
+}
+
+// appendHistory saves a PricePoint for every ask observed at t
+func (ai *AskIndex) appendHistory(asks map[string]StorageAsk, t time.Time) error {
+	txn, err := ai.ds.NewTransaction(false)
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
+	for addr, sa := range asks {
+		buf, err := cbor.DumpObject(PricePoint{Miner: addr, Price: sa.Price, Time: t})
+		if err != nil {
+			return err
+		}
+		if err := txn.Put(genHistoryKey(addr, t), buf); err != nil {
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+// getHistory returns the saved PricePoints in the range, sorted by time
+func (ai *AskIndex) getHistory(miner string, from, to time.Time) ([]PricePoint, error) {
+	prefix := dsHistory
+	if miner != "" {
+		prefix = prefix.ChildString(miner)
+	}
+	txn, err := ai.ds.NewTransaction(true)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: prefix.String(), KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []PricePoint
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		key := datastore.NewKey(r.Key)
+		// Prefix matching is done on strings, so /history/t01 also
+		// matches keys of miner t010
+		if miner != "" && key.Parent().Name() != miner {
+			continue
+		}
+		nanos, err := strconv.ParseInt(key.Name(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid history key %s", key)
+		}
+		t := time.Unix(0, nanos)
+		if (!from.IsZero() && t.Before(from)) || (!to.IsZero() && t.After(to)) {
+			continue
+		}
+		buf, err := txn.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		var pp PricePoint
+		if err := cbor.DecodeInto(buf, &pp); err != nil {
+			return nil, err
+		}
+		// time.Time doesn't survive the cbor roundtrip, the key has it
+		pp.Time = t
+		ret = append(ret, pp)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Time.Before(ret[j].Time)
+	})
+	return ret, nil
+}
+
+// dailyMedians returns the median price of each UTC day with points, sorted
+// by day
+func dailyMedians(points []PricePoint) []DailyPrice {
+	days := make(map[time.Time][]uint64)
+	for _, pp := range points {
+		day := pp.Time.UTC().Truncate(time.Hour * 24)
+		days[day] = append(days[day], pp.Price)
+	}
+	ret := make([]DailyPrice, 0, len(days))
+	for day, prices := range days {
+		ret = append(ret, DailyPrice{Day: day, Median: median(prices)})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Day.Before(ret[j].Day)
+	})
+	return ret
+}
+
+// volatility returns the coefficient of variation of the prices of points
+func volatility(points []PricePoint) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, pp := range points {
+		sum += float64(pp.Price)
+	}
+	mean := sum / float64(len(points))
+	if mean == 0 {
+		return 0
+	}
+	var variance float64
+	for _, pp := range points {
+		d := float64(pp.Price) - mean
+		variance += d * d
+	}
+	variance /= float64(len(points))
+	return math.Sqrt(variance) / mean
+}
+
+func genHistoryKey(miner string, t time.Time) datastore.Key {
+	return dsHistory.ChildString(miner).ChildString(fmt.Sprintf("%020d", t.UnixNano()))
+}
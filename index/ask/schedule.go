@@ -0,0 +1,54 @@
+package ask
+
+import (
+	"time"
+)
+
+// minerSchedule tracks the query results of a miner to decide when its ask
+// should be refreshed
+type minerSchedule struct {
+	Failures  int
+	NextQuery time.Time
+}
+
+// dueMiners returns the addrs that should be queried at now. Miners that were
+// never queried are always due.
+func (ai *AskIndex) dueMiners(addrs []string, now time.Time) []string {
+	var due []string
+	for _, addr := range addrs {
+		if ms, ok := ai.schedule[addr]; ok && now.Before(ms.NextQuery) {
+			continue
+		}
+		due = append(due, addr)
+	}
+	return due
+}
+
+// reschedule sets the next query time of queried miners. Miners that answered
+// are refreshed every qaRefreshInterval, and the others are backed off
+// exponentially up to qaMaxBackoff.
+func (ai *AskIndex) reschedule(queried []string, asks map[string]StorageAsk, now time.Time) {
+	for _, addr := range queried {
+		ms := ai.schedule[addr]
+		if _, ok := asks[addr]; ok {
+			ms.Failures = 0
+		} else {
+			ms.Failures++
+		}
+		ms.NextQuery = now.Add(backoff(ms.Failures))
+		ai.schedule[addr] = ms
+	}
+}
+
+// backoff returns the time to wait before querying a miner that failed
+// failures consecutive times
+func backoff(failures int) time.Duration {
+	d := qaRefreshInterval
+	for i := 0; i < failures; i++ {
+		d *= 2
+		if d >= qaMaxBackoff {
+			return qaMaxBackoff
+		}
+	}
+	return d
+}
@@ -9,22 +9,46 @@ import (
 func init() {
 	cbor.RegisterCborType(Index{})
 	cbor.RegisterCborType(StorageAsk{})
+	cbor.RegisterCborType(PricePoint{})
 	cbor.RegisterCborType(time.Time{})
 }
 
 // Index contains Ask information from markets
 type Index struct {
 	LastUpdated        time.Time
+	Height             uint64
 	StorageMedianPrice uint64
 	Storage            map[string]StorageAsk
 }
 
+// SortOrder indicates how Query results are sorted
+type SortOrder int
+
+const (
+	// SortByPrice sorts asks by ascending price
+	SortByPrice SortOrder = iota
+	// SortByPower sorts asks by descending miner power
+	SortByPower
+	// SortByFreshness sorts asks by descending timestamp
+	SortByFreshness
+)
+
 // Query specifies filtering and paging data to retrieve active Asks
 type Query struct {
-	MaxPrice  uint64
-	PieceSize uint64
-	Limit     int
-	Offset    int
+	MaxPrice       uint64
+	PieceSize      uint64
+	ExcludedMiners []string
+	IncludedMiners []string
+	// NotExpired excludes asks with an expiry lower or equal than the
+	// current height
+	NotExpired bool
+	MinPower   uint64
+	Countries  []string
+	// OnlyVerified excludes asks without a valid signature of the miner
+	OnlyVerified bool
+	SortBy       SortOrder
+	Limit        int
+	Offset       int
 }
 
 // StorageAsk has information about an active ask from a storage miner
@@ -34,4 +58,27 @@ type StorageAsk struct {
 	MinPieceSize uint64
 	Timestamp    uint64
 	Expiry       uint64
+	// Verified is true if the ask is signed by the miner worker key
+	Verified bool
+}
+
+// PricePoint is the price of a miner ask observed in a refresh
+type PricePoint struct {
+	Miner string
+	Price uint64
+	Time  time.Time
+}
+
+// DailyPrice is the median price of the asks observed during a day
+type DailyPrice struct {
+	Day    time.Time
+	Median uint64
+}
+
+// PriceHistory contains the observed prices of asks in a time range
+type PriceHistory struct {
+	Points       []PricePoint
+	DailyMedians []DailyPrice
+	// Volatility is the standard deviation of prices divided by their mean
+	Volatility float64
 }
@@ -27,14 +27,27 @@ type API struct {
 		ClientStartDeal        func(ctx context.Context, data cid.Cid, addr string, miner string, price types.BigInt, blocksDuration uint64) (*cid.Cid, error)
 		ClientImport           func(ctx context.Context, path string) (cid.Cid, error)
 		ClientGetDealInfo      func(context.Context, cid.Cid) (*types.DealInfo, error)
+		ClientFindData         func(ctx context.Context, root cid.Cid) ([]types.QueryOffer, error)
+		ClientRetrieve         func(ctx context.Context, order types.RetrievalOrder, path string) error
 		ChainNotify            func(context.Context) (<-chan []*types.HeadChange, error)
 		StateListMiners        func(context.Context, *types.TipSet) ([]string, error)
 		ClientQueryAsk         func(ctx context.Context, p peer.ID, miner string) (*types.SignedStorageAsk, error)
 		StateMinerPeerID       func(ctx context.Context, m string, ts *types.TipSet) (peer.ID, error)
+		StateMinerWorker       func(ctx context.Context, m string, ts *types.TipSet) (string, error)
+		WalletVerify           func(ctx context.Context, k string, msg []byte, sig *types.Signature) (bool, error)
 		Version                func(context.Context) (types.Version, error)
 		SyncState              func(context.Context) (*types.SyncState, error)
 		WalletNew              func(context.Context, string) (string, error)
 		WalletBalance          func(context.Context, string) (types.BigInt, error)
+		WalletList             func(context.Context) ([]string, error)
+		WalletDefaultAddress   func(context.Context) (string, error)
+		WalletSetDefault       func(context.Context, string) error
+		WalletExport           func(context.Context, string) (*types.KeyInfo, error)
+		WalletImport           func(context.Context, *types.KeyInfo) (string, error)
+		WalletSignMessage      func(context.Context, string, *types.Message) (*types.SignedMessage, error)
+		MpoolGetNonce          func(context.Context, string) (uint64, error)
+		MpoolPush              func(context.Context, *types.SignedMessage) (cid.Cid, error)
+		StateSearchMsg         func(context.Context, cid.Cid) (*types.MsgLookup, error)
 		StateMinerPower        func(context.Context, string, *types.TipSet) (types.MinerPower, error)
 		ChainHead              func(context.Context) (*types.TipSet, error)
 		ChainGetTipSet         func(context.Context, types.TipSetKey) (*types.TipSet, error)
@@ -101,6 +114,19 @@ func (a *API) ClientGetDealInfo(ctx context.Context, cid cid.Cid) (*types.DealIn
 	}
 	return di, err
 }
+func (a *API) ClientFindData(ctx context.Context, root cid.Cid) ([]types.QueryOffer, error) {
+	offers, err := a.Internal.ClientFindData(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling ClientFindData: %s", err)
+	}
+	return offers, nil
+}
+func (a *API) ClientRetrieve(ctx context.Context, order types.RetrievalOrder, path string) error {
+	if err := a.Internal.ClientRetrieve(ctx, order, path); err != nil {
+		return fmt.Errorf("error when calling ClientRetrieve: %s", err)
+	}
+	return nil
+}
 func (a *API) ChainNotify(ctx context.Context) (<-chan []*types.HeadChange, error) {
 	hc, err := a.Internal.ChainNotify(ctx)
 	if err != nil {
@@ -129,6 +155,20 @@ func (a *API) StateMinerPeerID(ctx context.Context, m string, ts *types.TipSet)
 	}
 	return pid, nil
 }
+func (a *API) StateMinerWorker(ctx context.Context, m string, ts *types.TipSet) (string, error) {
+	worker, err := a.Internal.StateMinerWorker(ctx, m, ts)
+	if err != nil {
+		return "", fmt.Errorf("error when calling StateMinerWorker: %s", err)
+	}
+	return worker, nil
+}
+func (a *API) WalletVerify(ctx context.Context, k string, msg []byte, sig *types.Signature) (bool, error) {
+	ok, err := a.Internal.WalletVerify(ctx, k, msg, sig)
+	if err != nil {
+		return false, fmt.Errorf("error when calling WalletVerify: %s", err)
+	}
+	return ok, nil
+}
 func (a *API) Version(ctx context.Context) (types.Version, error) {
 	v, err := a.Internal.Version(ctx)
 	if err != nil {
@@ -157,6 +197,68 @@ func (a *API) WalletBalance(ctx context.Context, addr string) (types.BigInt, err
 	}
 	return b, nil
 }
+func (a *API) WalletList(ctx context.Context) ([]string, error) {
+	addrs, err := a.Internal.WalletList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling WalletList: %s", err)
+	}
+	return addrs, nil
+}
+func (a *API) WalletDefaultAddress(ctx context.Context) (string, error) {
+	addr, err := a.Internal.WalletDefaultAddress(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error when calling WalletDefaultAddress: %s", err)
+	}
+	return addr, nil
+}
+func (a *API) WalletSetDefault(ctx context.Context, addr string) error {
+	if err := a.Internal.WalletSetDefault(ctx, addr); err != nil {
+		return fmt.Errorf("error when calling WalletSetDefault: %s", err)
+	}
+	return nil
+}
+func (a *API) WalletExport(ctx context.Context, addr string) (*types.KeyInfo, error) {
+	ki, err := a.Internal.WalletExport(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling WalletExport: %s", err)
+	}
+	return ki, nil
+}
+func (a *API) WalletImport(ctx context.Context, ki *types.KeyInfo) (string, error) {
+	addr, err := a.Internal.WalletImport(ctx, ki)
+	if err != nil {
+		return "", fmt.Errorf("error when calling WalletImport: %s", err)
+	}
+	return addr, nil
+}
+func (a *API) WalletSignMessage(ctx context.Context, addr string, msg *types.Message) (*types.SignedMessage, error) {
+	sm, err := a.Internal.WalletSignMessage(ctx, addr, msg)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling WalletSignMessage: %s", err)
+	}
+	return sm, nil
+}
+func (a *API) MpoolGetNonce(ctx context.Context, addr string) (uint64, error) {
+	nonce, err := a.Internal.MpoolGetNonce(ctx, addr)
+	if err != nil {
+		return 0, fmt.Errorf("error when calling MpoolGetNonce: %s", err)
+	}
+	return nonce, nil
+}
+func (a *API) MpoolPush(ctx context.Context, sm *types.SignedMessage) (cid.Cid, error) {
+	c, err := a.Internal.MpoolPush(ctx, sm)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("error when calling MpoolPush: %s", err)
+	}
+	return c, nil
+}
+func (a *API) StateSearchMsg(ctx context.Context, msg cid.Cid) (*types.MsgLookup, error) {
+	ml, err := a.Internal.StateSearchMsg(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling StateSearchMsg: %s", err)
+	}
+	return ml, nil
+}
 func (a *API) StateMinerPower(ctx context.Context, addr string, ts *types.TipSet) (types.MinerPower, error) {
 	mp, err := a.Internal.StateMinerPower(ctx, addr, ts)
 	if err != nil {
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const addressChecksumLength = 4
+
+const (
+	addressProtocolID = iota
+	addressProtocolSecp256k1
+	addressProtocolActor
+	addressProtocolBLS
+)
+
+var addressEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// AddressBytes returns the binary representation of a string encoded address,
+// as used when the address is serialized
+func AddressBytes(addr string) ([]byte, error) {
+	if len(addr) < 3 || (addr[0] != 't' && addr[0] != 'f') {
+		return nil, fmt.Errorf("invalid address %q", addr)
+	}
+	protocol := addr[1] - '0'
+	raw := addr[2:]
+	switch protocol {
+	case addressProtocolID:
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id address %q: %s", addr, err)
+		}
+		buf := make([]byte, binary.MaxVarintLen64+1)
+		buf[0] = protocol
+		n := binary.PutUvarint(buf[1:], id)
+		return buf[:n+1], nil
+	case addressProtocolSecp256k1, addressProtocolActor, addressProtocolBLS:
+		decoded, err := addressEncoding.DecodeString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %s", addr, err)
+		}
+		if len(decoded) <= addressChecksumLength {
+			return nil, fmt.Errorf("invalid address %q: too short", addr)
+		}
+		payload := decoded[:len(decoded)-addressChecksumLength]
+		b := append([]byte{protocol}, payload...)
+		h, err := blake2b.New(addressChecksumLength, nil)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = h.Write(b)
+		if !bytes.Equal(h.Sum(nil), decoded[len(payload):]) {
+			return nil, fmt.Errorf("invalid address %q: bad checksum", addr)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("unknown protocol of address %q", addr)
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAddressBytes(t *testing.T) {
+	t.Parallel()
+	b, err := AddressBytes("t01024")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, []byte{0, 0x80, 0x08}) {
+		t.Fatalf("unexpected id address bytes %x", b)
+	}
+	b, err = AddressBytes("t15ihq5ibzwki2b4ep2f46avlkrqzhpqgtga7pdrq")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 21 || b[0] != 1 {
+		t.Fatalf("unexpected secp256k1 address bytes %x", b)
+	}
+	if _, err := AddressBytes("t15ihq5ibzwki2b4ep2f46avlkrqzhpqgtga7pdra"); err == nil {
+		t.Fatal("expected checksum error")
+	}
+	if _, err := AddressBytes("x01024"); err == nil {
+		t.Fatal("expected network error")
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"io"
+
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// MarshalCBOR writes the tuple encoding of the ask, which is what the miner
+// signs in a SignedStorageAsk
+func (sa *StorageAsk) MarshalCBOR(w io.Writer) error {
+	if _, err := w.Write(cbg.CborEncodeMajorType(cbg.MajArray, 6)); err != nil {
+		return err
+	}
+	if err := sa.Price.MarshalCBOR(w); err != nil {
+		return err
+	}
+	if _, err := w.Write(cbg.CborEncodeMajorType(cbg.MajUnsignedInt, sa.MinPieceSize)); err != nil {
+		return err
+	}
+	miner, err := AddressBytes(sa.Miner)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(cbg.CborEncodeMajorType(cbg.MajByteString, uint64(len(miner)))); err != nil {
+		return err
+	}
+	if _, err := w.Write(miner); err != nil {
+		return err
+	}
+	for _, v := range []uint64{sa.Timestamp, sa.Expiry, sa.SeqNo} {
+		if _, err := w.Write(cbg.CborEncodeMajorType(cbg.MajUnsignedInt, v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
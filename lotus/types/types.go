@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
 type DealState = uint64
@@ -48,6 +49,28 @@ type DealInfo struct {
 	Duration      uint64
 }
 
+type QueryOffer struct {
+	Err string
+
+	Root cid.Cid
+
+	Size     uint64
+	MinPrice BigInt
+
+	Miner       string
+	MinerPeerID peer.ID
+}
+
+type RetrievalOrder struct {
+	Root  cid.Cid
+	Size  uint64
+	Total BigInt
+
+	Client      string
+	Miner       string
+	MinerPeerID peer.ID
+}
+
 type TipSet struct {
 	Cids   []cid.Cid
 	Blocks []*BlockHeader
@@ -123,3 +146,39 @@ type ActorState struct {
 	Balance BigInt
 	State   interface{}
 }
+
+type KeyInfo struct {
+	Type       string
+	PrivateKey []byte
+}
+
+type Message struct {
+	To   string
+	From string
+
+	Nonce uint64
+
+	Value BigInt
+
+	GasPrice BigInt
+	GasLimit BigInt
+
+	Method uint64
+	Params []byte
+}
+
+type SignedMessage struct {
+	Message   Message
+	Signature Signature
+}
+
+type MessageReceipt struct {
+	ExitCode uint8
+	Return   []byte
+	GasUsed  BigInt
+}
+
+type MsgLookup struct {
+	Receipt MessageReceipt
+	TipSet  *TipSet
+}
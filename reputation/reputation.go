@@ -90,6 +90,18 @@ func (rm *ReputationModule) GetTopMiners(n int) ([]MinerScore, error) {
 	return mr, nil
 }
 
+// GetScore returns the score of a miner, and false if the miner isn't ranked
+func (rm *ReputationModule) GetScore(addr string) (MinerScore, bool) {
+	rm.lockScores.Lock()
+	defer rm.lockScores.Unlock()
+	for _, ms := range rm.scores {
+		if ms.Addr == addr {
+			return ms, true
+		}
+	}
+	return MinerScore{}, false
+}
+
 // Close closes the ReputationModule
 func (rm *ReputationModule) Close() error {
 	rm.cancel()
@@ -5,17 +5,19 @@ import (
 
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
+	dssync "github.com/ipfs/go-datastore/sync"
 )
 
-// TxMapDatastore is a in-memory datastore that satisfies TxnDatastore
+// TxMapDatastore is a in-memory datastore that satisfies TxnDatastore, and is
+// safe for concurrent use
 type TxMapDatastore struct {
-	*datastore.MapDatastore
+	*dssync.MutexDatastore
 	lock sync.RWMutex
 }
 
 func NewTxMapDatastore() *TxMapDatastore {
 	return &TxMapDatastore{
-		MapDatastore: datastore.NewMapDatastore(),
+		MutexDatastore: dssync.MutexWrap(datastore.NewMapDatastore()),
 	}
 }
 
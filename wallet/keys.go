@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/textileio/filecoin/lotus/types"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	saltSize = 16
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// ErrEmptyPassphrase returns when exporting or importing a key without a
+	// passphrase
+	ErrEmptyPassphrase = errors.New("passphrase can't be empty")
+	// ErrInvalidPassphrase returns when an exported key can't be decrypted
+	// with the provided passphrase
+	ErrInvalidPassphrase = errors.New("exported key can't be decrypted with the provided passphrase")
+)
+
+// WalletExport returns the private key of the wallet with addr, encrypted with
+// AES-256-GCM using a key derived from passphrase with scrypt. The result is
+// the salt, the nonce and the encrypted key concatenated.
+func (m *Module) WalletExport(ctx context.Context, addr string, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+	ki, err := m.api.WalletExport(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := json.Marshal(ki)
+	if err != nil {
+		return nil, fmt.Errorf("error when marshaling key: %s", err)
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error when generating salt: %s", err)
+	}
+	gcm, err := newKeyCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("error when generating nonce: %s", err)
+	}
+	out := append(salt, nonce...)
+	return gcm.Seal(out, nonce, plain, nil), nil
+}
+
+// WalletImport decrypts a key exported with WalletExport using passphrase,
+// and imports it returning the address of the wallet
+func (m *Module) WalletImport(ctx context.Context, exported []byte, passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", ErrEmptyPassphrase
+	}
+	if len(exported) < saltSize {
+		return "", ErrInvalidPassphrase
+	}
+	gcm, err := newKeyCipher(passphrase, exported[:saltSize])
+	if err != nil {
+		return "", err
+	}
+	exported = exported[saltSize:]
+	if len(exported) < gcm.NonceSize() {
+		return "", ErrInvalidPassphrase
+	}
+	plain, err := gcm.Open(nil, exported[:gcm.NonceSize()], exported[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", ErrInvalidPassphrase
+	}
+	var ki types.KeyInfo
+	if err := json.Unmarshal(plain, &ki); err != nil {
+		return "", fmt.Errorf("error when unmarshaling key: %s", err)
+	}
+	return m.api.WalletImport(ctx, &ki)
+}
+
+// newKeyCipher returns the AES-256-GCM cipher keyed with passphrase and salt
+func newKeyCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("error when deriving key: %s", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
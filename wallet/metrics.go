@@ -0,0 +1,37 @@
+package wallet
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+var (
+	keyAddress, _ = tag.NewKey("address")
+
+	mBalance          = stats.Float64("wallet/balance", "Balance of watched wallets", "attoFIL")
+	mLowBalanceAlerts = stats.Int64("wallet/low-balance-alerts", "Low balance alerts", "By")
+
+	vBalance = &view.View{
+		Name:        "wallet/balance",
+		Measure:     mBalance,
+		Description: "Current balance of watched wallets",
+		TagKeys:     []tag.Key{keyAddress},
+		Aggregation: view.LastValue(),
+	}
+	vLowBalanceAlerts = &view.View{
+		Name:        "wallet/low-balance-alerts_count",
+		Measure:     mLowBalanceAlerts,
+		Description: "Number of low balance alerts of watched wallets",
+		TagKeys:     []tag.Key{keyAddress},
+		Aggregation: view.Count(),
+	}
+
+	views = []*view.View{vBalance, vLowBalanceAlerts}
+)
+
+func initMetrics() {
+	if err := view.Register(views...); err != nil {
+		log.Fatalf("Failed to register views: %v", err)
+	}
+}
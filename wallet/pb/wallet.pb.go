@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type MessageState int32
+
+const (
+	MessageState_PENDING MessageState = 0
+	MessageState_APPLIED MessageState = 1
+	MessageState_FAILED  MessageState = 2
+)
+
+var MessageState_name = map[int32]string{
+	0: "PENDING",
+	1: "APPLIED",
+	2: "FAILED",
+}
+
+var MessageState_value = map[string]int32{
+	"PENDING": 0,
+	"APPLIED": 1,
+	"FAILED":  2,
+}
+
+func (x MessageState) String() string {
+	return proto.EnumName(MessageState_name, int32(x))
+}
+
+func (MessageState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{0}
+}
+
 type NewWalletRequest struct {
 	Typ                  string   `protobuf:"bytes,1,opt,name=typ,proto3" json:"typ,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -142,7 +170,7 @@ func (m *WalletBalanceRequest) GetAddress() string {
 }
 
 type WalletBalanceReply struct {
-	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -173,138 +201,1756 @@ func (m *WalletBalanceReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WalletBalanceReply proto.InternalMessageInfo
 
-func (m *WalletBalanceReply) GetBalance() int64 {
+func (m *WalletBalanceReply) GetBalance() string {
 	if m != nil {
 		return m.Balance
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*NewWalletRequest)(nil), "filecoin.wallet.pb.NewWalletRequest")
-	proto.RegisterType((*NewWalletReply)(nil), "filecoin.wallet.pb.NewWalletReply")
-	proto.RegisterType((*WalletBalanceRequest)(nil), "filecoin.wallet.pb.WalletBalanceRequest")
-	proto.RegisterType((*WalletBalanceReply)(nil), "filecoin.wallet.pb.WalletBalanceReply")
+type WalletListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("wallet.proto", fileDescriptor_b88fd140af4deb6f) }
+func (m *WalletListRequest) Reset()         { *m = WalletListRequest{} }
+func (m *WalletListRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletListRequest) ProtoMessage()    {}
+func (*WalletListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{4}
+}
 
-var fileDescriptor_b88fd140af4deb6f = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x4f, 0xcc, 0xc9,
-	0x49, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4a, 0xcb, 0xcc, 0x49, 0x4d, 0xce,
-	0xcf, 0xcc, 0xd3, 0x83, 0x09, 0x27, 0x29, 0xa9, 0x70, 0x09, 0xf8, 0xa5, 0x96, 0x87, 0x83, 0xf9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x02, 0x5c, 0xcc, 0x25, 0x95, 0x05, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20, 0xa6, 0x92, 0x16, 0x17, 0x1f, 0x92, 0xaa, 0x82, 0x9c, 0x4a,
-	0x21, 0x09, 0x2e, 0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0xa8, 0x3a, 0x18, 0x57, 0xc9,
-	0x80, 0x4b, 0x04, 0xa2, 0xd0, 0x29, 0x31, 0x27, 0x31, 0x2f, 0x39, 0x15, 0x66, 0x2a, 0x6e, 0x1d,
-	0x7a, 0x5c, 0x42, 0x68, 0x3a, 0xa0, 0x36, 0x24, 0x41, 0xf8, 0x60, 0xf5, 0xcc, 0x41, 0x30, 0xae,
-	0xd1, 0x61, 0x46, 0x2e, 0x66, 0xc7, 0x00, 0x4f, 0xa1, 0x70, 0x2e, 0x4e, 0xb8, 0xab, 0x84, 0x54,
-	0xf4, 0x30, 0x7d, 0xa7, 0x87, 0xee, 0x35, 0x29, 0x25, 0x02, 0xaa, 0x0a, 0x72, 0x2a, 0x95, 0x18,
-	0x84, 0x92, 0xb9, 0x78, 0x51, 0x1c, 0x24, 0xa4, 0x81, 0x4d, 0x1b, 0x36, 0x5f, 0x4a, 0xa9, 0x11,
-	0xa1, 0x12, 0x6c, 0x89, 0x93, 0x1e, 0x97, 0x48, 0x66, 0xbe, 0x5e, 0x49, 0x6a, 0x45, 0x49, 0x66,
-	0x4e, 0x2a, 0x42, 0xb1, 0x13, 0x9f, 0x1b, 0xd4, 0x00, 0x88, 0xae, 0x00, 0xc6, 0x45, 0x4c, 0xcc,
-	0x21, 0x21, 0xae, 0x49, 0x6c, 0xe0, 0x48, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xc1,
-	0x7c, 0x4a, 0xd4, 0x01, 0x00, 0x00,
+func (m *WalletListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletListRequest.Unmarshal(m, b)
+}
+func (m *WalletListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletListRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletListRequest.Merge(m, src)
+}
+func (m *WalletListRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletListRequest.Size(m)
+}
+func (m *WalletListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletListRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_WalletListRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type WalletListReply struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	NewWallet(ctx context.Context, in *NewWalletRequest, opts ...grpc.CallOption) (*NewWalletReply, error)
-	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceReply, error)
+func (m *WalletListReply) Reset()         { *m = WalletListReply{} }
+func (m *WalletListReply) String() string { return proto.CompactTextString(m) }
+func (*WalletListReply) ProtoMessage()    {}
+func (*WalletListReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{5}
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *WalletListReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletListReply.Unmarshal(m, b)
+}
+func (m *WalletListReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletListReply.Marshal(b, m, deterministic)
+}
+func (m *WalletListReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletListReply.Merge(m, src)
+}
+func (m *WalletListReply) XXX_Size() int {
+	return xxx_messageInfo_WalletListReply.Size(m)
+}
+func (m *WalletListReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletListReply.DiscardUnknown(m)
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+var xxx_messageInfo_WalletListReply proto.InternalMessageInfo
+
+func (m *WalletListReply) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (c *aPIClient) NewWallet(ctx context.Context, in *NewWalletRequest, opts ...grpc.CallOption) (*NewWalletReply, error) {
-	out := new(NewWalletReply)
-	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/NewWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
+type WalletDefaultAddressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletDefaultAddressRequest) Reset()         { *m = WalletDefaultAddressRequest{} }
+func (m *WalletDefaultAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletDefaultAddressRequest) ProtoMessage()    {}
+func (*WalletDefaultAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{6}
+}
+
+func (m *WalletDefaultAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletDefaultAddressRequest.Unmarshal(m, b)
+}
+func (m *WalletDefaultAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletDefaultAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletDefaultAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletDefaultAddressRequest.Merge(m, src)
+}
+func (m *WalletDefaultAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletDefaultAddressRequest.Size(m)
+}
+func (m *WalletDefaultAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletDefaultAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletDefaultAddressRequest proto.InternalMessageInfo
+
+type WalletDefaultAddressReply struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletDefaultAddressReply) Reset()         { *m = WalletDefaultAddressReply{} }
+func (m *WalletDefaultAddressReply) String() string { return proto.CompactTextString(m) }
+func (*WalletDefaultAddressReply) ProtoMessage()    {}
+func (*WalletDefaultAddressReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{7}
+}
+
+func (m *WalletDefaultAddressReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletDefaultAddressReply.Unmarshal(m, b)
+}
+func (m *WalletDefaultAddressReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletDefaultAddressReply.Marshal(b, m, deterministic)
+}
+func (m *WalletDefaultAddressReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletDefaultAddressReply.Merge(m, src)
+}
+func (m *WalletDefaultAddressReply) XXX_Size() int {
+	return xxx_messageInfo_WalletDefaultAddressReply.Size(m)
+}
+func (m *WalletDefaultAddressReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletDefaultAddressReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletDefaultAddressReply proto.InternalMessageInfo
+
+func (m *WalletDefaultAddressReply) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceReply, error) {
-	out := new(WalletBalanceReply)
-	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+type WalletSetDefaultRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletSetDefaultRequest) Reset()         { *m = WalletSetDefaultRequest{} }
+func (m *WalletSetDefaultRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletSetDefaultRequest) ProtoMessage()    {}
+func (*WalletSetDefaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{8}
+}
+
+func (m *WalletSetDefaultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletSetDefaultRequest.Unmarshal(m, b)
+}
+func (m *WalletSetDefaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletSetDefaultRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletSetDefaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletSetDefaultRequest.Merge(m, src)
+}
+func (m *WalletSetDefaultRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletSetDefaultRequest.Size(m)
+}
+func (m *WalletSetDefaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletSetDefaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletSetDefaultRequest proto.InternalMessageInfo
+
+func (m *WalletSetDefaultRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	NewWallet(context.Context, *NewWalletRequest) (*NewWalletReply, error)
-	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceReply, error)
+type WalletSetDefaultReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+func (m *WalletSetDefaultReply) Reset()         { *m = WalletSetDefaultReply{} }
+func (m *WalletSetDefaultReply) String() string { return proto.CompactTextString(m) }
+func (*WalletSetDefaultReply) ProtoMessage()    {}
+func (*WalletSetDefaultReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{9}
 }
 
-func (*UnimplementedAPIServer) NewWallet(ctx context.Context, req *NewWalletRequest) (*NewWalletReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewWallet not implemented")
+func (m *WalletSetDefaultReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletSetDefaultReply.Unmarshal(m, b)
 }
-func (*UnimplementedAPIServer) WalletBalance(ctx context.Context, req *WalletBalanceRequest) (*WalletBalanceReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletBalance not implemented")
+func (m *WalletSetDefaultReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletSetDefaultReply.Marshal(b, m, deterministic)
+}
+func (m *WalletSetDefaultReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletSetDefaultReply.Merge(m, src)
+}
+func (m *WalletSetDefaultReply) XXX_Size() int {
+	return xxx_messageInfo_WalletSetDefaultReply.Size(m)
+}
+func (m *WalletSetDefaultReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletSetDefaultReply.DiscardUnknown(m)
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+var xxx_messageInfo_WalletSetDefaultReply proto.InternalMessageInfo
+
+type WalletExportRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_NewWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).NewWallet(ctx, in)
+func (m *WalletExportRequest) Reset()         { *m = WalletExportRequest{} }
+func (m *WalletExportRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletExportRequest) ProtoMessage()    {}
+func (*WalletExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{10}
+}
+
+func (m *WalletExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExportRequest.Unmarshal(m, b)
+}
+func (m *WalletExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExportRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExportRequest.Merge(m, src)
+}
+func (m *WalletExportRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletExportRequest.Size(m)
+}
+func (m *WalletExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExportRequest proto.InternalMessageInfo
+
+func (m *WalletExportRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filecoin.wallet.pb.API/NewWallet",
+	return ""
+}
+
+func (m *WalletExportRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).NewWallet(ctx, req.(*NewWalletRequest))
+	return ""
+}
+
+type WalletExportReply struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletExportReply) Reset()         { *m = WalletExportReply{} }
+func (m *WalletExportReply) String() string { return proto.CompactTextString(m) }
+func (*WalletExportReply) ProtoMessage()    {}
+func (*WalletExportReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{11}
+}
+
+func (m *WalletExportReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExportReply.Unmarshal(m, b)
+}
+func (m *WalletExportReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExportReply.Marshal(b, m, deterministic)
+}
+func (m *WalletExportReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExportReply.Merge(m, src)
+}
+func (m *WalletExportReply) XXX_Size() int {
+	return xxx_messageInfo_WalletExportReply.Size(m)
+}
+func (m *WalletExportReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExportReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExportReply proto.InternalMessageInfo
+
+func (m *WalletExportReply) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WalletBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type WalletImportRequest struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletImportRequest) Reset()         { *m = WalletImportRequest{} }
+func (m *WalletImportRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletImportRequest) ProtoMessage()    {}
+func (*WalletImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{12}
+}
+
+func (m *WalletImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletImportRequest.Unmarshal(m, b)
+}
+func (m *WalletImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletImportRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletImportRequest.Merge(m, src)
+}
+func (m *WalletImportRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletImportRequest.Size(m)
+}
+func (m *WalletImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletImportRequest proto.InternalMessageInfo
+
+func (m *WalletImportRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	if interceptor == nil {
-		return srv.(APIServer).WalletBalance(ctx, in)
+	return nil
+}
+
+func (m *WalletImportRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filecoin.wallet.pb.API/WalletBalance",
+	return ""
+}
+
+type WalletImportReply struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletImportReply) Reset()         { *m = WalletImportReply{} }
+func (m *WalletImportReply) String() string { return proto.CompactTextString(m) }
+func (*WalletImportReply) ProtoMessage()    {}
+func (*WalletImportReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{13}
+}
+
+func (m *WalletImportReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletImportReply.Unmarshal(m, b)
+}
+func (m *WalletImportReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletImportReply.Marshal(b, m, deterministic)
+}
+func (m *WalletImportReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletImportReply.Merge(m, src)
+}
+func (m *WalletImportReply) XXX_Size() int {
+	return xxx_messageInfo_WalletImportReply.Size(m)
+}
+func (m *WalletImportReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletImportReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletImportReply proto.InternalMessageInfo
+
+func (m *WalletImportReply) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+	return ""
+}
+
+type SendRequest struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	GasPrice             string   `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	GasLimit             string   `protobuf:"bytes,5,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendRequest) Reset()         { *m = SendRequest{} }
+func (m *SendRequest) String() string { return proto.CompactTextString(m) }
+func (*SendRequest) ProtoMessage()    {}
+func (*SendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{14}
+}
+
+func (m *SendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
+}
+func (m *SendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendRequest.Marshal(b, m, deterministic)
+}
+func (m *SendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRequest.Merge(m, src)
+}
+func (m *SendRequest) XXX_Size() int {
+	return xxx_messageInfo_SendRequest.Size(m)
+}
+func (m *SendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendRequest proto.InternalMessageInfo
+
+func (m *SendRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
+}
+
+func (m *SendRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SendRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SendRequest) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *SendRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+type SendReply struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendReply) Reset()         { *m = SendReply{} }
+func (m *SendReply) String() string { return proto.CompactTextString(m) }
+func (*SendReply) ProtoMessage()    {}
+func (*SendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{15}
+}
+
+func (m *SendReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendReply.Unmarshal(m, b)
+}
+func (m *SendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendReply.Marshal(b, m, deterministic)
+}
+func (m *SendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendReply.Merge(m, src)
+}
+func (m *SendReply) XXX_Size() int {
+	return xxx_messageInfo_SendReply.Size(m)
+}
+func (m *SendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendReply proto.InternalMessageInfo
+
+func (m *SendReply) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type MessageStatusRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessageStatusRequest) Reset()         { *m = MessageStatusRequest{} }
+func (m *MessageStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MessageStatusRequest) ProtoMessage()    {}
+func (*MessageStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{16}
+}
+
+func (m *MessageStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageStatusRequest.Unmarshal(m, b)
+}
+func (m *MessageStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *MessageStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageStatusRequest.Merge(m, src)
+}
+func (m *MessageStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_MessageStatusRequest.Size(m)
+}
+func (m *MessageStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageStatusRequest proto.InternalMessageInfo
+
+func (m *MessageStatusRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type MessageStatusReply struct {
+	Cid                  string       `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	State                MessageState `protobuf:"varint,2,opt,name=state,proto3,enum=filecoin.wallet.pb.MessageState" json:"state,omitempty"`
+	ExitCode             uint32       `protobuf:"varint,3,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Height               uint64       `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	GasUsed              string       `protobuf:"bytes,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MessageStatusReply) Reset()         { *m = MessageStatusReply{} }
+func (m *MessageStatusReply) String() string { return proto.CompactTextString(m) }
+func (*MessageStatusReply) ProtoMessage()    {}
+func (*MessageStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{17}
+}
+
+func (m *MessageStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageStatusReply.Unmarshal(m, b)
+}
+func (m *MessageStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageStatusReply.Marshal(b, m, deterministic)
+}
+func (m *MessageStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageStatusReply.Merge(m, src)
+}
+func (m *MessageStatusReply) XXX_Size() int {
+	return xxx_messageInfo_MessageStatusReply.Size(m)
+}
+func (m *MessageStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageStatusReply proto.InternalMessageInfo
+
+func (m *MessageStatusReply) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *MessageStatusReply) GetState() MessageState {
+	if m != nil {
+		return m.State
+	}
+	return MessageState_PENDING
+}
+
+func (m *MessageStatusReply) GetExitCode() uint32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *MessageStatusReply) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MessageStatusReply) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+type WatchAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Threshold            string   `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchAddressRequest) Reset()         { *m = WatchAddressRequest{} }
+func (m *WatchAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchAddressRequest) ProtoMessage()    {}
+func (*WatchAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{18}
+}
+
+func (m *WatchAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAddressRequest.Unmarshal(m, b)
+}
+func (m *WatchAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAddressRequest.Merge(m, src)
+}
+func (m *WatchAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchAddressRequest.Size(m)
+}
+func (m *WatchAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAddressRequest proto.InternalMessageInfo
+
+func (m *WatchAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WatchAddressRequest) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+type WatchAddressReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchAddressReply) Reset()         { *m = WatchAddressReply{} }
+func (m *WatchAddressReply) String() string { return proto.CompactTextString(m) }
+func (*WatchAddressReply) ProtoMessage()    {}
+func (*WatchAddressReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{19}
+}
+
+func (m *WatchAddressReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAddressReply.Unmarshal(m, b)
+}
+func (m *WatchAddressReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAddressReply.Marshal(b, m, deterministic)
+}
+func (m *WatchAddressReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAddressReply.Merge(m, src)
+}
+func (m *WatchAddressReply) XXX_Size() int {
+	return xxx_messageInfo_WatchAddressReply.Size(m)
+}
+func (m *WatchAddressReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAddressReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAddressReply proto.InternalMessageInfo
+
+type UnwatchAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnwatchAddressRequest) Reset()         { *m = UnwatchAddressRequest{} }
+func (m *UnwatchAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*UnwatchAddressRequest) ProtoMessage()    {}
+func (*UnwatchAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{20}
+}
+
+func (m *UnwatchAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnwatchAddressRequest.Unmarshal(m, b)
+}
+func (m *UnwatchAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnwatchAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *UnwatchAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwatchAddressRequest.Merge(m, src)
+}
+func (m *UnwatchAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_UnwatchAddressRequest.Size(m)
+}
+func (m *UnwatchAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwatchAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwatchAddressRequest proto.InternalMessageInfo
+
+func (m *UnwatchAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnwatchAddressReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnwatchAddressReply) Reset()         { *m = UnwatchAddressReply{} }
+func (m *UnwatchAddressReply) String() string { return proto.CompactTextString(m) }
+func (*UnwatchAddressReply) ProtoMessage()    {}
+func (*UnwatchAddressReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{21}
+}
+
+func (m *UnwatchAddressReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnwatchAddressReply.Unmarshal(m, b)
+}
+func (m *UnwatchAddressReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnwatchAddressReply.Marshal(b, m, deterministic)
+}
+func (m *UnwatchAddressReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwatchAddressReply.Merge(m, src)
+}
+func (m *UnwatchAddressReply) XXX_Size() int {
+	return xxx_messageInfo_UnwatchAddressReply.Size(m)
+}
+func (m *UnwatchAddressReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwatchAddressReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwatchAddressReply proto.InternalMessageInfo
+
+type WatchedAddress struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Threshold            string   `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Balance              string   `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Low                  bool     `protobuf:"varint,4,opt,name=low,proto3" json:"low,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchedAddress) Reset()         { *m = WatchedAddress{} }
+func (m *WatchedAddress) String() string { return proto.CompactTextString(m) }
+func (*WatchedAddress) ProtoMessage()    {}
+func (*WatchedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{22}
+}
+
+func (m *WatchedAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchedAddress.Unmarshal(m, b)
+}
+func (m *WatchedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchedAddress.Marshal(b, m, deterministic)
+}
+func (m *WatchedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchedAddress.Merge(m, src)
+}
+func (m *WatchedAddress) XXX_Size() int {
+	return xxx_messageInfo_WatchedAddress.Size(m)
+}
+func (m *WatchedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchedAddress proto.InternalMessageInfo
+
+func (m *WatchedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WatchedAddress) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *WatchedAddress) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *WatchedAddress) GetLow() bool {
+	if m != nil {
+		return m.Low
+	}
+	return false
+}
+
+type ListWatchedRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWatchedRequest) Reset()         { *m = ListWatchedRequest{} }
+func (m *ListWatchedRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWatchedRequest) ProtoMessage()    {}
+func (*ListWatchedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{23}
+}
+
+func (m *ListWatchedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWatchedRequest.Unmarshal(m, b)
+}
+func (m *ListWatchedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWatchedRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWatchedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWatchedRequest.Merge(m, src)
+}
+func (m *ListWatchedRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWatchedRequest.Size(m)
+}
+func (m *ListWatchedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWatchedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWatchedRequest proto.InternalMessageInfo
+
+type ListWatchedReply struct {
+	Addresses            []*WatchedAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListWatchedReply) Reset()         { *m = ListWatchedReply{} }
+func (m *ListWatchedReply) String() string { return proto.CompactTextString(m) }
+func (*ListWatchedReply) ProtoMessage()    {}
+func (*ListWatchedReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{24}
+}
+
+func (m *ListWatchedReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWatchedReply.Unmarshal(m, b)
+}
+func (m *ListWatchedReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWatchedReply.Marshal(b, m, deterministic)
+}
+func (m *ListWatchedReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWatchedReply.Merge(m, src)
+}
+func (m *ListWatchedReply) XXX_Size() int {
+	return xxx_messageInfo_ListWatchedReply.Size(m)
+}
+func (m *ListWatchedReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWatchedReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWatchedReply proto.InternalMessageInfo
+
+func (m *ListWatchedReply) GetAddresses() []*WatchedAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type BalanceSample struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceSample) Reset()         { *m = BalanceSample{} }
+func (m *BalanceSample) String() string { return proto.CompactTextString(m) }
+func (*BalanceSample) ProtoMessage()    {}
+func (*BalanceSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{25}
+}
+
+func (m *BalanceSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceSample.Unmarshal(m, b)
+}
+func (m *BalanceSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceSample.Marshal(b, m, deterministic)
+}
+func (m *BalanceSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceSample.Merge(m, src)
+}
+func (m *BalanceSample) XXX_Size() int {
+	return xxx_messageInfo_BalanceSample.Size(m)
+}
+func (m *BalanceSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceSample proto.InternalMessageInfo
+
+func (m *BalanceSample) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceSample) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *BalanceSample) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type BalanceHistoryRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceHistoryRequest) Reset()         { *m = BalanceHistoryRequest{} }
+func (m *BalanceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceHistoryRequest) ProtoMessage()    {}
+func (*BalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{26}
+}
+
+func (m *BalanceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceHistoryRequest.Unmarshal(m, b)
+}
+func (m *BalanceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *BalanceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceHistoryRequest.Merge(m, src)
+}
+func (m *BalanceHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_BalanceHistoryRequest.Size(m)
+}
+func (m *BalanceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceHistoryRequest proto.InternalMessageInfo
+
+func (m *BalanceHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *BalanceHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type BalanceHistoryReply struct {
+	Samples              []*BalanceSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BalanceHistoryReply) Reset()         { *m = BalanceHistoryReply{} }
+func (m *BalanceHistoryReply) String() string { return proto.CompactTextString(m) }
+func (*BalanceHistoryReply) ProtoMessage()    {}
+func (*BalanceHistoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{27}
+}
+
+func (m *BalanceHistoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceHistoryReply.Unmarshal(m, b)
+}
+func (m *BalanceHistoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceHistoryReply.Marshal(b, m, deterministic)
+}
+func (m *BalanceHistoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceHistoryReply.Merge(m, src)
+}
+func (m *BalanceHistoryReply) XXX_Size() int {
+	return xxx_messageInfo_BalanceHistoryReply.Size(m)
+}
+func (m *BalanceHistoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceHistoryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceHistoryReply proto.InternalMessageInfo
+
+func (m *BalanceHistoryReply) GetSamples() []*BalanceSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type LowBalanceAlertsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LowBalanceAlertsRequest) Reset()         { *m = LowBalanceAlertsRequest{} }
+func (m *LowBalanceAlertsRequest) String() string { return proto.CompactTextString(m) }
+func (*LowBalanceAlertsRequest) ProtoMessage()    {}
+func (*LowBalanceAlertsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{28}
+}
+
+func (m *LowBalanceAlertsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LowBalanceAlertsRequest.Unmarshal(m, b)
+}
+func (m *LowBalanceAlertsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LowBalanceAlertsRequest.Marshal(b, m, deterministic)
+}
+func (m *LowBalanceAlertsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowBalanceAlertsRequest.Merge(m, src)
+}
+func (m *LowBalanceAlertsRequest) XXX_Size() int {
+	return xxx_messageInfo_LowBalanceAlertsRequest.Size(m)
+}
+func (m *LowBalanceAlertsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowBalanceAlertsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowBalanceAlertsRequest proto.InternalMessageInfo
+
+type LowBalanceAlert struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Threshold            string   `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Height               uint64   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LowBalanceAlert) Reset()         { *m = LowBalanceAlert{} }
+func (m *LowBalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*LowBalanceAlert) ProtoMessage()    {}
+func (*LowBalanceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{29}
+}
+
+func (m *LowBalanceAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LowBalanceAlert.Unmarshal(m, b)
+}
+func (m *LowBalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LowBalanceAlert.Marshal(b, m, deterministic)
+}
+func (m *LowBalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LowBalanceAlert.Merge(m, src)
+}
+func (m *LowBalanceAlert) XXX_Size() int {
+	return xxx_messageInfo_LowBalanceAlert.Size(m)
+}
+func (m *LowBalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_LowBalanceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LowBalanceAlert proto.InternalMessageInfo
+
+func (m *LowBalanceAlert) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LowBalanceAlert) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *LowBalanceAlert) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *LowBalanceAlert) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LowBalanceAlert) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("filecoin.wallet.pb.MessageState", MessageState_name, MessageState_value)
+	proto.RegisterType((*NewWalletRequest)(nil), "filecoin.wallet.pb.NewWalletRequest")
+	proto.RegisterType((*NewWalletReply)(nil), "filecoin.wallet.pb.NewWalletReply")
+	proto.RegisterType((*WalletBalanceRequest)(nil), "filecoin.wallet.pb.WalletBalanceRequest")
+	proto.RegisterType((*WalletBalanceReply)(nil), "filecoin.wallet.pb.WalletBalanceReply")
+	proto.RegisterType((*WalletListRequest)(nil), "filecoin.wallet.pb.WalletListRequest")
+	proto.RegisterType((*WalletListReply)(nil), "filecoin.wallet.pb.WalletListReply")
+	proto.RegisterType((*WalletDefaultAddressRequest)(nil), "filecoin.wallet.pb.WalletDefaultAddressRequest")
+	proto.RegisterType((*WalletDefaultAddressReply)(nil), "filecoin.wallet.pb.WalletDefaultAddressReply")
+	proto.RegisterType((*WalletSetDefaultRequest)(nil), "filecoin.wallet.pb.WalletSetDefaultRequest")
+	proto.RegisterType((*WalletSetDefaultReply)(nil), "filecoin.wallet.pb.WalletSetDefaultReply")
+	proto.RegisterType((*WalletExportRequest)(nil), "filecoin.wallet.pb.WalletExportRequest")
+	proto.RegisterType((*WalletExportReply)(nil), "filecoin.wallet.pb.WalletExportReply")
+	proto.RegisterType((*WalletImportRequest)(nil), "filecoin.wallet.pb.WalletImportRequest")
+	proto.RegisterType((*WalletImportReply)(nil), "filecoin.wallet.pb.WalletImportReply")
+	proto.RegisterType((*SendRequest)(nil), "filecoin.wallet.pb.SendRequest")
+	proto.RegisterType((*SendReply)(nil), "filecoin.wallet.pb.SendReply")
+	proto.RegisterType((*MessageStatusRequest)(nil), "filecoin.wallet.pb.MessageStatusRequest")
+	proto.RegisterType((*MessageStatusReply)(nil), "filecoin.wallet.pb.MessageStatusReply")
+	proto.RegisterType((*WatchAddressRequest)(nil), "filecoin.wallet.pb.WatchAddressRequest")
+	proto.RegisterType((*WatchAddressReply)(nil), "filecoin.wallet.pb.WatchAddressReply")
+	proto.RegisterType((*UnwatchAddressRequest)(nil), "filecoin.wallet.pb.UnwatchAddressRequest")
+	proto.RegisterType((*UnwatchAddressReply)(nil), "filecoin.wallet.pb.UnwatchAddressReply")
+	proto.RegisterType((*WatchedAddress)(nil), "filecoin.wallet.pb.WatchedAddress")
+	proto.RegisterType((*ListWatchedRequest)(nil), "filecoin.wallet.pb.ListWatchedRequest")
+	proto.RegisterType((*ListWatchedReply)(nil), "filecoin.wallet.pb.ListWatchedReply")
+	proto.RegisterType((*BalanceSample)(nil), "filecoin.wallet.pb.BalanceSample")
+	proto.RegisterType((*BalanceHistoryRequest)(nil), "filecoin.wallet.pb.BalanceHistoryRequest")
+	proto.RegisterType((*BalanceHistoryReply)(nil), "filecoin.wallet.pb.BalanceHistoryReply")
+	proto.RegisterType((*LowBalanceAlertsRequest)(nil), "filecoin.wallet.pb.LowBalanceAlertsRequest")
+	proto.RegisterType((*LowBalanceAlert)(nil), "filecoin.wallet.pb.LowBalanceAlert")
+}
+
+func init() { proto.RegisterFile("wallet.proto", fileDescriptor_b88fd140af4deb6f) }
+
+var fileDescriptor_b88fd140af4deb6f = []byte{
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0x8f, 0x2c, 0xe7, 0x8f, 0x37, 0x89, 0xab, 0x5c, 0x12, 0xe2, 0x8a, 0x06, 0xca, 0x35, 0x69,
+	0x42, 0x99, 0xba, 0x25, 0x2d, 0xbc, 0xf0, 0x82, 0x43, 0xdc, 0xd6, 0x8c, 0x1b, 0x3c, 0x4a, 0x32,
+	0x99, 0x61, 0x86, 0x19, 0x14, 0xeb, 0x12, 0x6b, 0x90, 0x2d, 0xa3, 0xbb, 0x8c, 0xe3, 0x47, 0x3e,
+	0x01, 0xdf, 0x81, 0x67, 0xbe, 0x1e, 0xef, 0xcc, 0xe9, 0x4e, 0xf6, 0x49, 0x3e, 0x59, 0x86, 0xb7,
+	0xdb, 0xbb, 0xdf, 0xee, 0x6a, 0x7f, 0x77, 0xbb, 0x3f, 0x1b, 0x36, 0x46, 0x6e, 0x10, 0x10, 0x56,
+	0x1f, 0x46, 0x21, 0x0b, 0x11, 0xba, 0xf5, 0x03, 0xd2, 0x0d, 0xfd, 0x41, 0x3d, 0xd9, 0xbe, 0xc1,
+	0x07, 0x60, 0x9d, 0x93, 0xd1, 0x75, 0x6c, 0x3b, 0xe4, 0xf7, 0x7b, 0x42, 0x19, 0xb2, 0xc0, 0x64,
+	0xe3, 0x61, 0xcd, 0x78, 0x6a, 0x1c, 0x57, 0x1c, 0xbe, 0xc4, 0x2f, 0xa0, 0xaa, 0xa0, 0x86, 0xc1,
+	0x18, 0xd5, 0x60, 0xd5, 0xf5, 0xbc, 0x88, 0x50, 0x2a, 0x71, 0x89, 0x89, 0x5f, 0xc3, 0x8e, 0x00,
+	0x9e, 0xba, 0x81, 0x3b, 0xe8, 0x92, 0x24, 0x6a, 0xbe, 0xc7, 0x5b, 0x40, 0x19, 0x0f, 0x99, 0xe1,
+	0x46, 0xd8, 0xb5, 0x92, 0xc0, 0x4b, 0xf3, 0xc7, 0xf2, 0x9a, 0x61, 0x95, 0xf0, 0x36, 0x6c, 0x09,
+	0xaf, 0xb6, 0x4f, 0x93, 0x4f, 0xc7, 0xaf, 0xe0, 0x91, 0xba, 0xc9, 0xe3, 0x3c, 0x81, 0x8a, 0x4c,
+	0x44, 0x78, 0x66, 0xf3, 0xb8, 0xe2, 0x4c, 0x37, 0xf0, 0x3e, 0x7c, 0x2a, 0x1c, 0xce, 0xc8, 0xad,
+	0x7b, 0x1f, 0xb0, 0x86, 0x38, 0x49, 0xe2, 0x7d, 0x03, 0x8f, 0xf5, 0xc7, 0xf3, 0x39, 0x78, 0x03,
+	0x7b, 0xc2, 0xed, 0x62, 0xe2, 0x59, 0x4c, 0xc3, 0x1e, 0xec, 0xce, 0x3a, 0x0d, 0x83, 0x31, 0xfe,
+	0x09, 0xb6, 0xc5, 0x41, 0xf3, 0x61, 0x18, 0x46, 0xc5, 0x91, 0xd0, 0x67, 0x00, 0x43, 0x97, 0xd2,
+	0x61, 0x2f, 0x72, 0x69, 0xc2, 0x9e, 0xb2, 0x83, 0x0f, 0x61, 0x2b, 0x1d, 0x90, 0x57, 0x63, 0x81,
+	0xf9, 0x1b, 0x19, 0xc7, 0xa1, 0x36, 0x1c, 0xbe, 0xc4, 0xef, 0x93, 0xbc, 0xad, 0xbe, 0x9a, 0x77,
+	0x06, 0x58, 0x98, 0xef, 0x25, 0x6c, 0xa5, 0x03, 0xcd, 0x67, 0xef, 0x0f, 0x03, 0xd6, 0x2f, 0xc8,
+	0xc0, 0x4b, 0x12, 0x22, 0x28, 0xdf, 0x46, 0x61, 0x5f, 0xc2, 0xe2, 0x35, 0xaa, 0x42, 0x89, 0x85,
+	0x32, 0x55, 0x89, 0x85, 0xe8, 0x13, 0x58, 0x71, 0xfb, 0xe1, 0xfd, 0x80, 0xd5, 0xcc, 0x78, 0x4f,
+	0x5a, 0xc8, 0x86, 0xb5, 0x3b, 0x97, 0x76, 0x22, 0xbf, 0x4b, 0x6a, 0xe5, 0xf8, 0x64, 0x62, 0xcb,
+	0xb3, 0xb6, 0xdf, 0xf7, 0x59, 0x6d, 0x79, 0x72, 0x16, 0xdb, 0x78, 0x1f, 0x2a, 0xe2, 0x13, 0x24,
+	0x35, 0x5d, 0xdf, 0x4b, 0x1a, 0xa2, 0xeb, 0x7b, 0xf8, 0x18, 0x76, 0x3e, 0x12, 0x4a, 0xdd, 0x3b,
+	0x72, 0xc1, 0x5c, 0x76, 0x4f, 0x15, 0x6e, 0x32, 0xc8, 0xbf, 0x0d, 0x40, 0x19, 0xa8, 0x36, 0x24,
+	0xfa, 0x16, 0x96, 0x29, 0x73, 0x99, 0xe0, 0xaf, 0x7a, 0xf2, 0xb4, 0x3e, 0xdb, 0xad, 0x75, 0x25,
+	0x10, 0x71, 0x04, 0x9c, 0x57, 0x41, 0x1e, 0x7c, 0xf6, 0x43, 0xe8, 0x91, 0xb8, 0xf6, 0x4d, 0x67,
+	0x62, 0x73, 0x56, 0x7a, 0xc4, 0xbf, 0xeb, 0xb1, 0xb8, 0xf6, 0xb2, 0x23, 0x2d, 0xce, 0xfd, 0x9d,
+	0x4b, 0xaf, 0x28, 0xf1, 0x64, 0xe1, 0x89, 0x89, 0x3f, 0xf2, 0x3b, 0x67, 0xdd, 0x5e, 0xba, 0x0f,
+	0xe6, 0xbc, 0xb5, 0x27, 0x50, 0x61, 0xbd, 0x88, 0xd0, 0x5e, 0x18, 0x78, 0xf2, 0x3e, 0xa6, 0x1b,
+	0xa2, 0x49, 0xd5, 0x70, 0xfc, 0x3d, 0x7f, 0x0d, 0xbb, 0x57, 0x83, 0xd1, 0x7f, 0xc9, 0x82, 0x77,
+	0x61, 0x3b, 0xeb, 0xc2, 0x23, 0x31, 0xa8, 0xc6, 0xe1, 0x89, 0x27, 0xb7, 0xff, 0xef, 0x87, 0xaa,
+	0xd3, 0xc6, 0x4c, 0x4d, 0x1b, 0x7e, 0x53, 0x41, 0x38, 0x8a, 0x09, 0x5c, 0x73, 0xf8, 0x12, 0xef,
+	0x00, 0xe2, 0xe3, 0x45, 0x66, 0x4e, 0x46, 0xc5, 0x25, 0x58, 0xa9, 0x5d, 0x7e, 0xcb, 0xdf, 0x67,
+	0x67, 0xcf, 0xfa, 0x09, 0xd6, 0xdd, 0x6b, 0xba, 0x08, 0x75, 0x3e, 0x5d, 0xc1, 0xa6, 0x9c, 0x8a,
+	0x17, 0x6e, 0x7f, 0x18, 0xa8, 0x57, 0x6a, 0x64, 0xaf, 0x54, 0x3f, 0x2e, 0x79, 0xfb, 0x30, 0xbf,
+	0x2f, 0xea, 0x32, 0x9d, 0x78, 0x8d, 0xaf, 0x60, 0x57, 0x86, 0xfd, 0xe0, 0x53, 0x16, 0x46, 0xe3,
+	0xe2, 0x8b, 0x4e, 0xba, 0xb0, 0x24, 0xc2, 0x28, 0x5d, 0x28, 0x02, 0x97, 0x58, 0x88, 0x1d, 0xd8,
+	0xce, 0x86, 0xe5, 0x34, 0x7c, 0x07, 0xab, 0x34, 0xfe, 0xfa, 0x84, 0x84, 0x2f, 0x74, 0x24, 0xa4,
+	0xea, 0x74, 0x12, 0x0f, 0xfc, 0x18, 0xf6, 0xda, 0xe1, 0x48, 0x1e, 0x36, 0x02, 0x12, 0xb1, 0xc9,
+	0x74, 0xfe, 0xd3, 0x80, 0x47, 0x99, 0xb3, 0x39, 0x05, 0xe4, 0x33, 0x94, 0x7a, 0x1a, 0x66, 0xf6,
+	0x69, 0xe4, 0x35, 0x51, 0xc2, 0xeb, 0xf2, 0x94, 0xd7, 0x17, 0x6f, 0x61, 0x43, 0xed, 0x51, 0xb4,
+	0x0e, 0xab, 0x9d, 0xe6, 0xf9, 0x59, 0xeb, 0xfc, 0xbd, 0xb5, 0xc4, 0x8d, 0x46, 0xa7, 0xd3, 0x6e,
+	0x35, 0xcf, 0x2c, 0x03, 0x01, 0xac, 0xbc, 0x6b, 0xb4, 0xda, 0xcd, 0x33, 0xab, 0x74, 0xf2, 0x0f,
+	0x80, 0xd9, 0xe8, 0xb4, 0xd0, 0x35, 0x54, 0x26, 0x32, 0x8b, 0x0e, 0x74, 0x1c, 0x65, 0xb5, 0xda,
+	0xc6, 0x05, 0x28, 0xde, 0x25, 0x4b, 0xa8, 0x0b, 0x9b, 0x29, 0x85, 0x45, 0xc7, 0xfa, 0x57, 0x38,
+	0x2b, 0xdb, 0xf6, 0xf3, 0x05, 0x90, 0x22, 0xc9, 0xcf, 0x00, 0x53, 0xed, 0x45, 0x87, 0xf9, 0x7e,
+	0x8a, 0x60, 0xdb, 0xcf, 0x8a, 0x60, 0x22, 0xf6, 0x43, 0xf2, 0xa3, 0x22, 0xad, 0xc3, 0xe8, 0x55,
+	0xbe, 0xbb, 0x56, 0xd0, 0xed, 0x97, 0x8b, 0x3b, 0x88, 0xcc, 0x01, 0x58, 0x59, 0x55, 0x46, 0x5f,
+	0xe5, 0x07, 0x99, 0x11, 0x7c, 0xfb, 0xcb, 0xc5, 0xc0, 0x22, 0xdb, 0xaf, 0xb0, 0xa1, 0x2a, 0x33,
+	0x3a, 0xca, 0x77, 0x4e, 0xfd, 0x18, 0xb0, 0x0f, 0x8b, 0x81, 0x99, 0x0c, 0xad, 0x7e, 0x51, 0x86,
+	0x56, 0x7f, 0xc1, 0x0c, 0x8a, 0xac, 0xe3, 0x25, 0xf4, 0x01, 0xca, 0x5c, 0x3a, 0xd1, 0xe7, 0x3a,
+	0x07, 0x45, 0xd7, 0xed, 0xfd, 0x7c, 0xc0, 0xe4, 0xd9, 0xa6, 0xa4, 0x53, 0xff, 0x6c, 0x75, 0x42,
+	0x6c, 0x3f, 0x5f, 0x00, 0xa9, 0x10, 0x32, 0x15, 0x96, 0x3c, 0x42, 0x66, 0xd4, 0xca, 0x3e, 0x2c,
+	0x06, 0x8a, 0x0c, 0xb7, 0x50, 0x4d, 0x8b, 0x17, 0xd2, 0xbe, 0x09, 0xad, 0x26, 0xda, 0x47, 0x8b,
+	0x40, 0x45, 0x9e, 0x5f, 0x60, 0x5d, 0x51, 0x20, 0xa4, 0xa5, 0x60, 0x56, 0xb8, 0xec, 0x83, 0x42,
+	0xdc, 0xa4, 0x8c, 0xf4, 0x70, 0xd7, 0x97, 0xa1, 0xd5, 0x15, 0xfb, 0x68, 0x11, 0xa8, 0xc8, 0xd3,
+	0x03, 0x2b, 0x3b, 0xf0, 0xf5, 0x1d, 0x97, 0x23, 0x0b, 0xf6, 0xb3, 0x05, 0xc0, 0x78, 0xe9, 0xb5,
+	0x71, 0x5a, 0x87, 0x1d, 0x3f, 0xac, 0x33, 0xf2, 0xc0, 0xfc, 0x80, 0x4c, 0xb1, 0xa7, 0xd5, 0x77,
+	0xd2, 0x5f, 0x3c, 0xef, 0x8e, 0xf1, 0x57, 0xc9, 0xbc, 0xbc, 0x6c, 0xde, 0xac, 0xc4, 0xff, 0xa3,
+	0xde, 0xfc, 0x3b, 0x00, 0x8f, 0x4a, 0x4d, 0x1e, 0x57, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	NewWallet(ctx context.Context, in *NewWalletRequest, opts ...grpc.CallOption) (*NewWalletReply, error)
+	WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceReply, error)
+	WalletList(ctx context.Context, in *WalletListRequest, opts ...grpc.CallOption) (*WalletListReply, error)
+	WalletDefaultAddress(ctx context.Context, in *WalletDefaultAddressRequest, opts ...grpc.CallOption) (*WalletDefaultAddressReply, error)
+	WalletSetDefault(ctx context.Context, in *WalletSetDefaultRequest, opts ...grpc.CallOption) (*WalletSetDefaultReply, error)
+	WalletExport(ctx context.Context, in *WalletExportRequest, opts ...grpc.CallOption) (*WalletExportReply, error)
+	WalletImport(ctx context.Context, in *WalletImportRequest, opts ...grpc.CallOption) (*WalletImportReply, error)
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error)
+	MessageStatus(ctx context.Context, in *MessageStatusRequest, opts ...grpc.CallOption) (*MessageStatusReply, error)
+	WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressReply, error)
+	UnwatchAddress(ctx context.Context, in *UnwatchAddressRequest, opts ...grpc.CallOption) (*UnwatchAddressReply, error)
+	ListWatched(ctx context.Context, in *ListWatchedRequest, opts ...grpc.CallOption) (*ListWatchedReply, error)
+	BalanceHistory(ctx context.Context, in *BalanceHistoryRequest, opts ...grpc.CallOption) (*BalanceHistoryReply, error)
+	LowBalanceAlerts(ctx context.Context, in *LowBalanceAlertsRequest, opts ...grpc.CallOption) (API_LowBalanceAlertsClient, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) NewWallet(ctx context.Context, in *NewWalletRequest, opts ...grpc.CallOption) (*NewWalletReply, error) {
+	out := new(NewWalletReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/NewWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletBalance(ctx context.Context, in *WalletBalanceRequest, opts ...grpc.CallOption) (*WalletBalanceReply, error) {
+	out := new(WalletBalanceReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletList(ctx context.Context, in *WalletListRequest, opts ...grpc.CallOption) (*WalletListReply, error) {
+	out := new(WalletListReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletDefaultAddress(ctx context.Context, in *WalletDefaultAddressRequest, opts ...grpc.CallOption) (*WalletDefaultAddressReply, error) {
+	out := new(WalletDefaultAddressReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletDefaultAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletSetDefault(ctx context.Context, in *WalletSetDefaultRequest, opts ...grpc.CallOption) (*WalletSetDefaultReply, error) {
+	out := new(WalletSetDefaultReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletSetDefault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletExport(ctx context.Context, in *WalletExportRequest, opts ...grpc.CallOption) (*WalletExportReply, error) {
+	out := new(WalletExportReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WalletImport(ctx context.Context, in *WalletImportRequest, opts ...grpc.CallOption) (*WalletImportReply, error) {
+	out := new(WalletImportReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WalletImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendReply, error) {
+	out := new(SendReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) MessageStatus(ctx context.Context, in *MessageStatusRequest, opts ...grpc.CallOption) (*MessageStatusReply, error) {
+	out := new(MessageStatusReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/MessageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WatchAddress(ctx context.Context, in *WatchAddressRequest, opts ...grpc.CallOption) (*WatchAddressReply, error) {
+	out := new(WatchAddressReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/WatchAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnwatchAddress(ctx context.Context, in *UnwatchAddressRequest, opts ...grpc.CallOption) (*UnwatchAddressReply, error) {
+	out := new(UnwatchAddressReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/UnwatchAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWatched(ctx context.Context, in *ListWatchedRequest, opts ...grpc.CallOption) (*ListWatchedReply, error) {
+	out := new(ListWatchedReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/ListWatched", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) BalanceHistory(ctx context.Context, in *BalanceHistoryRequest, opts ...grpc.CallOption) (*BalanceHistoryReply, error) {
+	out := new(BalanceHistoryReply)
+	err := c.cc.Invoke(ctx, "/filecoin.wallet.pb.API/BalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) LowBalanceAlerts(ctx context.Context, in *LowBalanceAlertsRequest, opts ...grpc.CallOption) (API_LowBalanceAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/filecoin.wallet.pb.API/LowBalanceAlerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPILowBalanceAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_LowBalanceAlertsClient interface {
+	Recv() (*LowBalanceAlert, error)
+	grpc.ClientStream
+}
+
+type aPILowBalanceAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPILowBalanceAlertsClient) Recv() (*LowBalanceAlert, error) {
+	m := new(LowBalanceAlert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServer is the server API for API service.
+type APIServer interface {
+	NewWallet(context.Context, *NewWalletRequest) (*NewWalletReply, error)
+	WalletBalance(context.Context, *WalletBalanceRequest) (*WalletBalanceReply, error)
+	WalletList(context.Context, *WalletListRequest) (*WalletListReply, error)
+	WalletDefaultAddress(context.Context, *WalletDefaultAddressRequest) (*WalletDefaultAddressReply, error)
+	WalletSetDefault(context.Context, *WalletSetDefaultRequest) (*WalletSetDefaultReply, error)
+	WalletExport(context.Context, *WalletExportRequest) (*WalletExportReply, error)
+	WalletImport(context.Context, *WalletImportRequest) (*WalletImportReply, error)
+	Send(context.Context, *SendRequest) (*SendReply, error)
+	MessageStatus(context.Context, *MessageStatusRequest) (*MessageStatusReply, error)
+	WatchAddress(context.Context, *WatchAddressRequest) (*WatchAddressReply, error)
+	UnwatchAddress(context.Context, *UnwatchAddressRequest) (*UnwatchAddressReply, error)
+	ListWatched(context.Context, *ListWatchedRequest) (*ListWatchedReply, error)
+	BalanceHistory(context.Context, *BalanceHistoryRequest) (*BalanceHistoryReply, error)
+	LowBalanceAlerts(*LowBalanceAlertsRequest, API_LowBalanceAlertsServer) error
+}
+
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (*UnimplementedAPIServer) NewWallet(ctx context.Context, req *NewWalletRequest) (*NewWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewWallet not implemented")
+}
+func (*UnimplementedAPIServer) WalletBalance(ctx context.Context, req *WalletBalanceRequest) (*WalletBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletBalance not implemented")
+}
+func (*UnimplementedAPIServer) WalletList(ctx context.Context, req *WalletListRequest) (*WalletListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletList not implemented")
+}
+func (*UnimplementedAPIServer) WalletDefaultAddress(ctx context.Context, req *WalletDefaultAddressRequest) (*WalletDefaultAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletDefaultAddress not implemented")
+}
+func (*UnimplementedAPIServer) WalletSetDefault(ctx context.Context, req *WalletSetDefaultRequest) (*WalletSetDefaultReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletSetDefault not implemented")
+}
+func (*UnimplementedAPIServer) WalletExport(ctx context.Context, req *WalletExportRequest) (*WalletExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletExport not implemented")
+}
+func (*UnimplementedAPIServer) WalletImport(ctx context.Context, req *WalletImportRequest) (*WalletImportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletImport not implemented")
+}
+func (*UnimplementedAPIServer) Send(ctx context.Context, req *SendRequest) (*SendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (*UnimplementedAPIServer) MessageStatus(ctx context.Context, req *MessageStatusRequest) (*MessageStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageStatus not implemented")
+}
+func (*UnimplementedAPIServer) WatchAddress(ctx context.Context, req *WatchAddressRequest) (*WatchAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchAddress not implemented")
+}
+func (*UnimplementedAPIServer) UnwatchAddress(ctx context.Context, req *UnwatchAddressRequest) (*UnwatchAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchAddress not implemented")
+}
+func (*UnimplementedAPIServer) ListWatched(ctx context.Context, req *ListWatchedRequest) (*ListWatchedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatched not implemented")
+}
+func (*UnimplementedAPIServer) BalanceHistory(ctx context.Context, req *BalanceHistoryRequest) (*BalanceHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceHistory not implemented")
+}
+func (*UnimplementedAPIServer) LowBalanceAlerts(req *LowBalanceAlertsRequest, srv API_LowBalanceAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method LowBalanceAlerts not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_NewWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).NewWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/NewWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).NewWallet(ctx, req.(*NewWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletBalance(ctx, req.(*WalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletList(ctx, req.(*WalletListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletDefaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletDefaultAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletDefaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletDefaultAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletDefaultAddress(ctx, req.(*WalletDefaultAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletSetDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletSetDefaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletSetDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletSetDefault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletSetDefault(ctx, req.(*WalletSetDefaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletExport(ctx, req.(*WalletExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WalletImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WalletImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WalletImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WalletImport(ctx, req.(*WalletImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Send(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_MessageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).MessageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/MessageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MessageStatus(ctx, req.(*MessageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).WatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/WatchAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).WatchAddress(ctx, req.(*WatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UnwatchAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnwatchAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/UnwatchAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnwatchAddress(ctx, req.(*UnwatchAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListWatched_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListWatched(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/ListWatched",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListWatched(ctx, req.(*ListWatchedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.wallet.pb.API/BalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).BalanceHistory(ctx, req.(*BalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_LowBalanceAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LowBalanceAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).LowBalanceAlerts(m, &aPILowBalanceAlertsServer{stream})
+}
+
+type API_LowBalanceAlertsServer interface {
+	Send(*LowBalanceAlert) error
+	grpc.ServerStream
+}
+
+type aPILowBalanceAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPILowBalanceAlertsServer) Send(m *LowBalanceAlert) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 var _API_serviceDesc = grpc.ServiceDesc{
@@ -319,7 +1965,57 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WalletBalance",
 			Handler:    _API_WalletBalance_Handler,
 		},
+		{
+			MethodName: "WalletList",
+			Handler:    _API_WalletList_Handler,
+		},
+		{
+			MethodName: "WalletDefaultAddress",
+			Handler:    _API_WalletDefaultAddress_Handler,
+		},
+		{
+			MethodName: "WalletSetDefault",
+			Handler:    _API_WalletSetDefault_Handler,
+		},
+		{
+			MethodName: "WalletExport",
+			Handler:    _API_WalletExport_Handler,
+		},
+		{
+			MethodName: "WalletImport",
+			Handler:    _API_WalletImport_Handler,
+		},
+		{
+			MethodName: "Send",
+			Handler:    _API_Send_Handler,
+		},
+		{
+			MethodName: "MessageStatus",
+			Handler:    _API_MessageStatus_Handler,
+		},
+		{
+			MethodName: "WatchAddress",
+			Handler:    _API_WatchAddress_Handler,
+		},
+		{
+			MethodName: "UnwatchAddress",
+			Handler:    _API_UnwatchAddress_Handler,
+		},
+		{
+			MethodName: "ListWatched",
+			Handler:    _API_ListWatched_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _API_BalanceHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LowBalanceAlerts",
+			Handler:       _API_LowBalanceAlerts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "wallet.proto",
 }
@@ -0,0 +1,165 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+const (
+	// DefaultGasLimit is the gas limit of a Send if none is configured
+	DefaultGasLimit = 1000
+)
+
+var (
+	// nonceTTL is the time a locally tracked nonce is used without the pool
+	// catching up with it. After it, the messages are considered dropped
+	// from the pool and the pool nonce is used again.
+	nonceTTL = time.Minute * 10
+)
+
+// localNonce is the next nonce of a wallet, tracked until the pool sees the
+// messages sent with the previous ones
+type localNonce struct {
+	next    uint64
+	updated time.Time
+}
+
+// MessageState is the state of a message in the chain
+type MessageState int
+
+const (
+	// MessagePending is a message that isn't included in the chain yet
+	MessagePending MessageState = iota
+	// MessageApplied is a message executed successfully
+	MessageApplied
+	// MessageFailed is a message executed with a non-zero exit code
+	MessageFailed
+)
+
+func (s MessageState) String() string {
+	switch s {
+	case MessagePending:
+		return "pending"
+	case MessageApplied:
+		return "applied"
+	case MessageFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// MessageStatus is the state of a message sent with Send
+type MessageStatus struct {
+	Cid      cid.Cid
+	State    MessageState
+	ExitCode uint8
+	Height   uint64
+	GasUsed  types.BigInt
+}
+
+// SendConfig contains optional settings for Send
+type SendConfig struct {
+	GasPrice types.BigInt
+	GasLimit types.BigInt
+}
+
+// SendOption modifies a SendConfig
+type SendOption func(*SendConfig)
+
+// WithGasPrice sets the price in attoFIL per unit of gas of the message
+func WithGasPrice(price types.BigInt) SendOption {
+	return func(c *SendConfig) {
+		c.GasPrice = price
+	}
+}
+
+// WithGasLimit sets the maximum gas the message can use
+func WithGasLimit(limit types.BigInt) SendOption {
+	return func(c *SendConfig) {
+		c.GasLimit = limit
+	}
+}
+
+// Send transfers amount attoFIL from wallet from to address to, returning the
+// cid of the message. Nonces are tracked locally, so many messages from the
+// same wallet can be sent before they're included in the chain. A local nonce
+// is dropped once the pool catches up with it, or if the pool doesn't see it
+// for nonceTTL.
+func (m *Module) Send(ctx context.Context, from string, to string, amount types.BigInt, opts ...SendOption) (cid.Cid, error) {
+	config := SendConfig{
+		GasPrice: types.NewInt(0),
+		GasLimit: types.NewInt(DefaultGasLimit),
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if amount.Nil() || amount.Sign() <= 0 {
+		return cid.Undef, fmt.Errorf("amount to send should be positive")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	nonce, err := m.api.MpoolGetNonce(ctx, from)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if ln, ok := m.nonces[from]; ok {
+		switch {
+		case nonce >= ln.next:
+			delete(m.nonces, from)
+		case time.Since(ln.updated) > nonceTTL:
+			log.Warnf("pool nonce of %s is behind since %s, resetting local nonce %d to %d", from, ln.updated, ln.next, nonce)
+			delete(m.nonces, from)
+		default:
+			// previous messages aren't in the pool yet
+			nonce = ln.next
+		}
+	}
+	msg := &types.Message{
+		To:       to,
+		From:     from,
+		Nonce:    nonce,
+		Value:    amount,
+		GasPrice: config.GasPrice,
+		GasLimit: config.GasLimit,
+	}
+	sm, err := m.api.WalletSignMessage(ctx, from, msg)
+	if err != nil {
+		return cid.Undef, err
+	}
+	c, err := m.api.MpoolPush(ctx, sm)
+	if err != nil {
+		return cid.Undef, err
+	}
+	m.nonces[from] = localNonce{next: nonce + 1, updated: time.Now()}
+	return c, nil
+}
+
+// MessageStatus returns the state of a message sent with Send
+func (m *Module) MessageStatus(ctx context.Context, msg cid.Cid) (MessageStatus, error) {
+	ml, err := m.api.StateSearchMsg(ctx, msg)
+	if err != nil {
+		return MessageStatus{}, err
+	}
+	if ml == nil {
+		return MessageStatus{Cid: msg, State: MessagePending}, nil
+	}
+	status := MessageStatus{
+		Cid:      msg,
+		State:    MessageApplied,
+		ExitCode: ml.Receipt.ExitCode,
+		GasUsed:  ml.Receipt.GasUsed,
+	}
+	if ml.TipSet != nil {
+		status.Height = ml.TipSet.Height
+	}
+	if ml.Receipt.ExitCode != 0 {
+		status.State = MessageFailed
+	}
+	return status, nil
+}
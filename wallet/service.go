@@ -2,20 +2,26 @@ package wallet
 
 import (
 	"context"
+	"time"
 
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/filecoin/lotus/types"
 	pb "github.com/textileio/filecoin/wallet/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Service implements the gprc service
 type Service struct {
 	pb.UnimplementedAPIServer
 
-	Module *Module
+	Module  *Module
+	Watcher *Watcher
 }
 
 // NewService creates a new Service
-func NewService(m *Module) *Service {
-	return &Service{Module: m}
+func NewService(m *Module, w *Watcher) *Service {
+	return &Service{Module: m, Watcher: w}
 }
 
 // NewWallet creates a new wallet
@@ -33,5 +39,204 @@ func (s *Service) WalletBalance(ctx context.Context, req *pb.WalletBalanceReques
 	if err != nil {
 		return nil, err
 	}
-	return &pb.WalletBalanceReply{Balance: res.Int64()}, nil
+	return &pb.WalletBalanceReply{Balance: res.String()}, nil
+}
+
+// WalletList returns the addresses of all the wallets
+func (s *Service) WalletList(ctx context.Context, req *pb.WalletListRequest) (*pb.WalletListReply, error) {
+	addrs, err := s.Module.WalletList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WalletListReply{Addresses: addrs}, nil
+}
+
+// WalletDefaultAddress returns the address of the default wallet
+func (s *Service) WalletDefaultAddress(ctx context.Context, req *pb.WalletDefaultAddressRequest) (*pb.WalletDefaultAddressReply, error) {
+	addr, err := s.Module.WalletDefaultAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WalletDefaultAddressReply{Address: addr}, nil
+}
+
+// WalletSetDefault sets the default wallet
+func (s *Service) WalletSetDefault(ctx context.Context, req *pb.WalletSetDefaultRequest) (*pb.WalletSetDefaultReply, error) {
+	if err := s.Module.WalletSetDefault(ctx, req.GetAddress()); err != nil {
+		return nil, err
+	}
+	return &pb.WalletSetDefaultReply{}, nil
+}
+
+// WalletExport exports the key of a wallet encrypted with a passphrase
+func (s *Service) WalletExport(ctx context.Context, req *pb.WalletExportRequest) (*pb.WalletExportReply, error) {
+	key, err := s.Module.WalletExport(ctx, req.GetAddress(), req.GetPassphrase())
+	if err != nil {
+		return nil, toKeyError(err)
+	}
+	return &pb.WalletExportReply{Key: key}, nil
+}
+
+// WalletImport imports a key exported with WalletExport
+func (s *Service) WalletImport(ctx context.Context, req *pb.WalletImportRequest) (*pb.WalletImportReply, error) {
+	addr, err := s.Module.WalletImport(ctx, req.GetKey(), req.GetPassphrase())
+	if err != nil {
+		return nil, toKeyError(err)
+	}
+	return &pb.WalletImportReply{Address: addr}, nil
+}
+
+// Send transfers funds between wallets
+func (s *Service) Send(ctx context.Context, req *pb.SendRequest) (*pb.SendReply, error) {
+	amount, err := types.BigFromString(req.GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %q", req.GetAmount())
+	}
+	var opts []SendOption
+	if req.GetGasPrice() != "" {
+		gasPrice, err := types.BigFromString(req.GetGasPrice())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid gas price %q", req.GetGasPrice())
+		}
+		opts = append(opts, WithGasPrice(gasPrice))
+	}
+	if req.GetGasLimit() != "" {
+		gasLimit, err := types.BigFromString(req.GetGasLimit())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid gas limit %q", req.GetGasLimit())
+		}
+		opts = append(opts, WithGasLimit(gasLimit))
+	}
+	c, err := s.Module.Send(ctx, req.GetFrom(), req.GetTo(), amount, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SendReply{Cid: c.String()}, nil
+}
+
+// MessageStatus returns the state of a message sent with Send
+func (s *Service) MessageStatus(ctx context.Context, req *pb.MessageStatusRequest) (*pb.MessageStatusReply, error) {
+	c, err := cid.Decode(req.GetCid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message cid: %s", err)
+	}
+	ms, err := s.Module.MessageStatus(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.MessageStatusReply{
+		Cid:      ms.Cid.String(),
+		State:    pb.MessageState(ms.State),
+		ExitCode: uint32(ms.ExitCode),
+		Height:   ms.Height,
+	}
+	if !ms.GasUsed.Nil() {
+		reply.GasUsed = ms.GasUsed.String()
+	}
+	return reply, nil
+}
+
+// WatchAddress tracks the balance of an address
+func (s *Service) WatchAddress(ctx context.Context, req *pb.WatchAddressRequest) (*pb.WatchAddressReply, error) {
+	threshold := types.NewInt(0)
+	if req.GetThreshold() != "" {
+		var err error
+		if threshold, err = types.BigFromString(req.GetThreshold()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid threshold %q", req.GetThreshold())
+		}
+	}
+	if err := s.Watcher.Watch(req.GetAddress(), threshold); err != nil {
+		return nil, err
+	}
+	return &pb.WatchAddressReply{}, nil
+}
+
+// UnwatchAddress stops tracking the balance of an address
+func (s *Service) UnwatchAddress(ctx context.Context, req *pb.UnwatchAddressRequest) (*pb.UnwatchAddressReply, error) {
+	if err := s.Watcher.Unwatch(req.GetAddress()); err != nil {
+		if err == ErrAddressNotWatched {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.UnwatchAddressReply{}, nil
+}
+
+// ListWatched returns the addresses with tracked balances
+func (s *Service) ListWatched(ctx context.Context, req *pb.ListWatchedRequest) (*pb.ListWatchedReply, error) {
+	watched, err := s.Watcher.Watched()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]*pb.WatchedAddress, len(watched))
+	for i, wa := range watched {
+		addrs[i] = &pb.WatchedAddress{
+			Address:   wa.Addr,
+			Threshold: bigString(wa.Threshold),
+			Balance:   bigString(wa.Balance),
+			Low:       wa.Low,
+		}
+	}
+	return &pb.ListWatchedReply{Addresses: addrs}, nil
+}
+
+// BalanceHistory returns the balance changes of a watched address
+func (s *Service) BalanceHistory(ctx context.Context, req *pb.BalanceHistoryRequest) (*pb.BalanceHistoryReply, error) {
+	var from, to time.Time
+	if req.GetFrom() != 0 {
+		from = time.Unix(0, req.GetFrom())
+	}
+	if req.GetTo() != 0 {
+		to = time.Unix(0, req.GetTo())
+	}
+	history, err := s.Watcher.History(req.GetAddress(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	samples := make([]*pb.BalanceSample, len(history))
+	for i, bs := range history {
+		samples[i] = &pb.BalanceSample{
+			Height:  bs.Height,
+			Balance: bigString(bs.Balance),
+			Time:    bs.Time.UnixNano(),
+		}
+	}
+	return &pb.BalanceHistoryReply{Samples: samples}, nil
+}
+
+// LowBalanceAlerts streams the alerts of watched addresses falling under their
+// threshold
+func (s *Service) LowBalanceAlerts(req *pb.LowBalanceAlertsRequest, srv pb.API_LowBalanceAlertsServer) error {
+	for alert := range s.Watcher.Alerts(srv.Context()) {
+		err := srv.Send(&pb.LowBalanceAlert{
+			Address:   alert.Addr,
+			Balance:   bigString(alert.Balance),
+			Threshold: bigString(alert.Threshold),
+			Height:    alert.Height,
+			Time:      alert.Time.UnixNano(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bigString returns the decimal string of b, or an empty string if it's nil
+func bigString(b types.BigInt) string {
+	if b.Nil() {
+		return ""
+	}
+	return b.String()
+}
+
+func toKeyError(err error) error {
+	switch err {
+	case ErrEmptyPassphrase:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ErrInvalidPassphrase:
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return err
+	}
 }
@@ -2,7 +2,9 @@ package wallet
 
 import (
 	"context"
+	"sync"
 
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/filecoin/lotus/types"
 )
 
@@ -10,17 +12,30 @@ import (
 type API interface {
 	WalletNew(ctx context.Context, typ string) (string, error)
 	WalletBalance(ctx context.Context, addr string) (types.BigInt, error)
+	WalletList(ctx context.Context) ([]string, error)
+	WalletDefaultAddress(ctx context.Context) (string, error)
+	WalletSetDefault(ctx context.Context, addr string) error
+	WalletExport(ctx context.Context, addr string) (*types.KeyInfo, error)
+	WalletImport(ctx context.Context, ki *types.KeyInfo) (string, error)
+	WalletSignMessage(ctx context.Context, addr string, msg *types.Message) (*types.SignedMessage, error)
+	MpoolGetNonce(ctx context.Context, addr string) (uint64, error)
+	MpoolPush(ctx context.Context, sm *types.SignedMessage) (cid.Cid, error)
+	StateSearchMsg(ctx context.Context, msg cid.Cid) (*types.MsgLookup, error)
 }
 
 // Module exposes the filecoin wallet api.
 type Module struct {
 	api API
+
+	lock   sync.Mutex
+	nonces map[string]localNonce
 }
 
 // New creates a new wallet module
 func New(api API) *Module {
 	m := &Module{
-		api: api,
+		api:    api,
+		nonces: make(map[string]localNonce),
 	}
 	return m
 }
@@ -34,3 +49,18 @@ func (m *Module) NewWallet(ctx context.Context, typ string) (string, error) {
 func (m *Module) WalletBalance(ctx context.Context, addr string) (types.BigInt, error) {
 	return m.api.WalletBalance(ctx, addr)
 }
+
+// WalletList returns the addresses of all the wallets
+func (m *Module) WalletList(ctx context.Context) ([]string, error) {
+	return m.api.WalletList(ctx)
+}
+
+// WalletDefaultAddress returns the address of the default wallet
+func (m *Module) WalletDefaultAddress(ctx context.Context) (string, error) {
+	return m.api.WalletDefaultAddress(ctx)
+}
+
+// WalletSetDefault sets the wallet with addr as the default one
+func (m *Module) WalletSetDefault(ctx context.Context, addr string) error {
+	return m.api.WalletSetDefault(ctx, addr)
+}
@@ -0,0 +1,190 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/textileio/filecoin/lotus/types"
+)
+
+type mockAPI struct {
+	keys map[string]types.KeyInfo
+	def  string
+
+	poolNonce uint64
+	pushed    []types.Message
+	lookups   map[cid.Cid]*types.MsgLookup
+}
+
+func (a *mockAPI) WalletNew(ctx context.Context, typ string) (string, error) {
+	return "t3new", nil
+}
+
+func (a *mockAPI) WalletBalance(ctx context.Context, addr string) (types.BigInt, error) {
+	return types.NewInt(0), nil
+}
+
+func (a *mockAPI) WalletList(ctx context.Context) ([]string, error) {
+	var addrs []string
+	for addr := range a.keys {
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func (a *mockAPI) WalletDefaultAddress(ctx context.Context) (string, error) {
+	return a.def, nil
+}
+
+func (a *mockAPI) WalletSetDefault(ctx context.Context, addr string) error {
+	a.def = addr
+	return nil
+}
+
+func (a *mockAPI) WalletExport(ctx context.Context, addr string) (*types.KeyInfo, error) {
+	ki := a.keys[addr]
+	return &ki, nil
+}
+
+func (a *mockAPI) WalletImport(ctx context.Context, ki *types.KeyInfo) (string, error) {
+	addr := "t3imported"
+	a.keys[addr] = *ki
+	return addr, nil
+}
+
+func (a *mockAPI) WalletSignMessage(ctx context.Context, addr string, msg *types.Message) (*types.SignedMessage, error) {
+	return &types.SignedMessage{Message: *msg, Signature: types.Signature{Type: types.KTBLS, Data: []byte(addr)}}, nil
+}
+
+func (a *mockAPI) MpoolGetNonce(ctx context.Context, addr string) (uint64, error) {
+	return a.poolNonce, nil
+}
+
+func (a *mockAPI) MpoolPush(ctx context.Context, sm *types.SignedMessage) (cid.Cid, error) {
+	a.pushed = append(a.pushed, sm.Message)
+	return cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte(fmt.Sprintf("msg-%d", sm.Message.Nonce)))
+}
+
+func (a *mockAPI) StateSearchMsg(ctx context.Context, msg cid.Cid) (*types.MsgLookup, error) {
+	return a.lookups[msg], nil
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := &mockAPI{poolNonce: 5, lookups: make(map[cid.Cid]*types.MsgLookup)}
+	m := New(api)
+
+	c1, err := m.Send(ctx, "t3from", "t3to", types.NewInt(100))
+	checkErr(t, err)
+	// the pool doesn't see the first message yet
+	c2, err := m.Send(ctx, "t3from", "t3to", types.NewInt(200), WithGasPrice(types.NewInt(2)), WithGasLimit(types.NewInt(5000)))
+	checkErr(t, err)
+	if len(api.pushed) != 2 || api.pushed[0].Nonce != 5 || api.pushed[1].Nonce != 6 {
+		t.Fatalf("unexpected pushed messages %v", api.pushed)
+	}
+	if !api.pushed[0].GasLimit.Equals(types.NewInt(DefaultGasLimit)) || !api.pushed[1].GasPrice.Equals(types.NewInt(2)) || !api.pushed[1].GasLimit.Equals(types.NewInt(5000)) {
+		t.Fatalf("unexpected gas settings %v", api.pushed)
+	}
+	// the pool nonce is used when it's ahead of the local one
+	api.poolNonce = 10
+	_, err = m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	if api.pushed[2].Nonce != 10 {
+		t.Fatalf("expected nonce 10, got %d", api.pushed[2].Nonce)
+	}
+	if _, err := m.Send(ctx, "t3from", "t3to", types.NewInt(0)); err == nil {
+		t.Fatal("expected an error when sending zero")
+	}
+
+	api.lookups[c1] = &types.MsgLookup{Receipt: types.MessageReceipt{GasUsed: types.NewInt(10)}, TipSet: &types.TipSet{Height: 42}}
+	api.lookups[c2] = &types.MsgLookup{Receipt: types.MessageReceipt{ExitCode: 1, GasUsed: types.NewInt(10)}, TipSet: &types.TipSet{Height: 43}}
+	status, err := m.MessageStatus(ctx, c1)
+	checkErr(t, err)
+	if status.State != MessageApplied || status.Height != 42 {
+		t.Fatalf("unexpected status %v", status)
+	}
+	status, err = m.MessageStatus(ctx, c2)
+	checkErr(t, err)
+	if status.State != MessageFailed || status.ExitCode != 1 {
+		t.Fatalf("unexpected status %v", status)
+	}
+	c3, err := m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	status, err = m.MessageStatus(ctx, c3)
+	checkErr(t, err)
+	if status.State != MessagePending {
+		t.Fatalf("expected pending message, got %v", status)
+	}
+}
+
+func TestSendStaleNonce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	api := &mockAPI{poolNonce: 5}
+	m := New(api)
+
+	_, err := m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	_, err = m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	if api.pushed[1].Nonce != 6 {
+		t.Fatalf("expected local nonce 6, got %d", api.pushed[1].Nonce)
+	}
+
+	// the pool caught up, so the local nonce is dropped
+	api.poolNonce = 7
+	_, err = m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	if api.pushed[2].Nonce != 7 || m.nonces["t3from"].next != 8 {
+		t.Fatalf("expected pool nonce 7, got %d", api.pushed[2].Nonce)
+	}
+
+	// the message with nonce 7 was dropped from the pool
+	m.nonces["t3from"] = localNonce{next: 8, updated: time.Now().Add(-nonceTTL * 2)}
+	_, err = m.Send(ctx, "t3from", "t3to", types.NewInt(1))
+	checkErr(t, err)
+	if api.pushed[3].Nonce != 7 {
+		t.Fatalf("expected stale local nonce to be reset to 7, got %d", api.pushed[3].Nonce)
+	}
+}
+
+func TestWalletExportImport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ki := types.KeyInfo{Type: types.KTBLS, PrivateKey: []byte("secret key")}
+	api := &mockAPI{keys: map[string]types.KeyInfo{"t3addr": ki}}
+	m := New(api)
+
+	exported, err := m.WalletExport(ctx, "t3addr", "passphrase")
+	checkErr(t, err)
+	if bytes.Contains(exported, ki.PrivateKey) {
+		t.Fatal("exported key isn't encrypted")
+	}
+
+	if _, err := m.WalletImport(ctx, exported, "wrong"); err != ErrInvalidPassphrase {
+		t.Fatalf("expected ErrInvalidPassphrase, got %v", err)
+	}
+	addr, err := m.WalletImport(ctx, exported, "passphrase")
+	checkErr(t, err)
+	imported := api.keys[addr]
+	if imported.Type != ki.Type || !bytes.Equal(imported.PrivateKey, ki.PrivateKey) {
+		t.Fatalf("imported key doesn't match exported one: %v", imported)
+	}
+
+	if _, err := m.WalletExport(ctx, "t3addr", ""); err != ErrEmptyPassphrase {
+		t.Fatalf("expected ErrEmptyPassphrase, got %v", err)
+	}
+}
+
+func checkErr(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
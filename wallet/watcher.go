@@ -0,0 +1,390 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log"
+	"github.com/textileio/filecoin/lotus/types"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+)
+
+const (
+	alertsBuffer = 10
+)
+
+var (
+	log = logging.Logger("wallet")
+
+	// ErrAddressNotWatched returns when the address isn't registered in the
+	// Watcher
+	ErrAddressNotWatched = errors.New("address isn't watched")
+
+	// notifyMinBackoff is the wait before subscribing again to new chain
+	// heads after a failure, which doubles up to notifyMaxBackoff while
+	// failures continue
+	notifyMinBackoff = time.Second
+	notifyMaxBackoff = time.Minute
+
+	dsBaseWatched  = datastore.NewKey("/watched")
+	dsBaseBalances = datastore.NewKey("/balances")
+)
+
+// WatcherAPI interacts with a Filecoin full-node to watch wallet balances
+type WatcherAPI interface {
+	ChainNotify(ctx context.Context) (<-chan []*types.HeadChange, error)
+	WalletBalance(ctx context.Context, addr string) (types.BigInt, error)
+}
+
+// WatchedAddress is a wallet address whose balance is tracked by the Watcher
+type WatchedAddress struct {
+	Addr string
+	// Threshold is the balance under which a LowBalanceAlert is emitted. If
+	// zero, no alerts are emitted.
+	Threshold types.BigInt
+	// Balance is the last known balance
+	Balance types.BigInt
+	// Low is true while the balance is under the threshold
+	Low bool
+}
+
+// BalanceSample is the balance of a wallet at a chain height
+type BalanceSample struct {
+	Height  uint64
+	Balance types.BigInt
+	Time    time.Time
+}
+
+// LowBalanceAlert is emitted when the balance of a watched wallet falls under
+// its threshold
+type LowBalanceAlert struct {
+	Addr      string
+	Balance   types.BigInt
+	Threshold types.BigInt
+	Height    uint64
+	Time      time.Time
+}
+
+// Watcher tracks balances of registered wallet addresses on every new chain
+// head, persisting a time series of their changes and alerting when they fall
+// under a threshold.
+type Watcher struct {
+	api WatcherAPI
+	ds  datastore.TxnDatastore
+	// watchedLock serializes changes of watched addresses
+	watchedLock sync.Mutex
+
+	lock      sync.Mutex
+	listeners map[chan LowBalanceAlert]struct{}
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+	clsLock  sync.Mutex
+	closed   bool
+}
+
+// NewWatcher returns a new Watcher that persists its state in ds, and starts
+// tracking balances on new chain heads.
+func NewWatcher(ds datastore.TxnDatastore, api WatcherAPI) *Watcher {
+	initMetrics()
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Watcher{
+		api:       api,
+		ds:        ds,
+		listeners: make(map[chan LowBalanceAlert]struct{}),
+		ctx:       ctx,
+		cancel:    cancel,
+		finished:  make(chan struct{}),
+	}
+	go w.start()
+	return w
+}
+
+// Watch registers addr to track its balance, alerting when it falls under
+// threshold. Watching an address again updates its threshold.
+func (w *Watcher) Watch(addr string, threshold types.BigInt) error {
+	if threshold.Nil() {
+		threshold = types.NewInt(0)
+	}
+	w.watchedLock.Lock()
+	defer w.watchedLock.Unlock()
+	wa, err := w.getWatched(addr)
+	if err != nil && err != ErrAddressNotWatched {
+		return err
+	}
+	wa.Addr = addr
+	wa.Threshold = threshold
+	return w.putWatched(wa)
+}
+
+// Unwatch stops tracking the balance of addr, keeping its history
+func (w *Watcher) Unwatch(addr string) error {
+	w.watchedLock.Lock()
+	defer w.watchedLock.Unlock()
+	if _, err := w.getWatched(addr); err != nil {
+		return err
+	}
+	return w.ds.Delete(dsBaseWatched.ChildString(addr))
+}
+
+// Watched returns all the watched addresses
+func (w *Watcher) Watched() ([]WatchedAddress, error) {
+	res, err := w.ds.Query(query.Query{Prefix: dsBaseWatched.String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []WatchedAddress
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var wa WatchedAddress
+		if err := json.Unmarshal(r.Value, &wa); err != nil {
+			return nil, err
+		}
+		ret = append(ret, wa)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Addr < ret[j].Addr
+	})
+	return ret, nil
+}
+
+// History returns the balance changes of addr between from and to, ordered by
+// time. A zero to means up to now.
+func (w *Watcher) History(addr string, from, to time.Time) ([]BalanceSample, error) {
+	res, err := w.ds.Query(query.Query{Prefix: dsBaseBalances.ChildString(addr).String()})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	var ret []BalanceSample
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var bs BalanceSample
+		if err := json.Unmarshal(r.Value, &bs); err != nil {
+			return nil, err
+		}
+		if bs.Time.Before(from) || (!to.IsZero() && bs.Time.After(to)) {
+			continue
+		}
+		ret = append(ret, bs)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Time.Before(ret[j].Time)
+	})
+	return ret, nil
+}
+
+// Alerts returns a channel with the LowBalanceAlerts emitted until ctx is
+// done, when the channel gets closed
+func (w *Watcher) Alerts(ctx context.Context) <-chan LowBalanceAlert {
+	ch := make(chan LowBalanceAlert, alertsBuffer)
+	w.lock.Lock()
+	w.listeners[ch] = struct{}{}
+	w.lock.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-w.ctx.Done():
+		}
+		w.lock.Lock()
+		delete(w.listeners, ch)
+		close(ch)
+		w.lock.Unlock()
+	}()
+	return ch
+}
+
+// Close closes the Watcher
+func (w *Watcher) Close() error {
+	w.clsLock.Lock()
+	defer w.clsLock.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.cancel()
+	<-w.finished
+	w.closed = true
+	return nil
+}
+
+// start is a long running job that updates balances on every new chain head.
+// If the subscription to new heads fails or ends, it subscribes again with
+// exponential backoff.
+func (w *Watcher) start() {
+	defer close(w.finished)
+	backoff := notifyMinBackoff
+	for {
+		if w.watchHeads() {
+			backoff = notifyMinBackoff
+		}
+		select {
+		case <-w.ctx.Done():
+			log.Info("graceful shutdown of wallet watcher")
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > notifyMaxBackoff {
+			backoff = notifyMaxBackoff
+		}
+	}
+}
+
+// watchHeads updates balances on new chain heads until the subscription ends,
+// returning true if any head was received
+func (w *Watcher) watchHeads() bool {
+	n, err := w.api.ChainNotify(w.ctx)
+	if err != nil {
+		log.Errorf("error when getting notify channel from lotus: %s", err)
+		return false
+	}
+	received := false
+	for {
+		select {
+		case <-w.ctx.Done():
+			return received
+		case hcs, ok := <-n:
+			if !ok {
+				log.Warn("lotus notify channel closed, subscribing again")
+				return received
+			}
+			received = true
+			if len(hcs) == 0 || hcs[len(hcs)-1].Val == nil {
+				continue
+			}
+			w.updateBalances(w.ctx, hcs[len(hcs)-1].Val.Height)
+		}
+	}
+}
+
+// updateBalances gets the balance of every watched address at height,
+// recording it and alerting about the ones that fell under their threshold
+func (w *Watcher) updateBalances(ctx context.Context, height uint64) {
+	watched, err := w.Watched()
+	if err != nil {
+		log.Errorf("error when getting watched addresses: %s", err)
+		return
+	}
+	for _, wa := range watched {
+		balance, err := w.api.WalletBalance(ctx, wa.Addr)
+		if err != nil {
+			log.Errorf("error when getting balance of %s: %s", wa.Addr, err)
+			continue
+		}
+		if err := w.updateBalance(wa.Addr, balance, height); err != nil {
+			log.Errorf("error when updating balance of %s: %s", wa.Addr, err)
+		}
+	}
+}
+
+// updateBalance records the balance of a watched address, and emits a
+// LowBalanceAlert when it falls under its threshold
+func (w *Watcher) updateBalance(addr string, balance types.BigInt, height uint64) error {
+	w.watchedLock.Lock()
+	defer w.watchedLock.Unlock()
+	wa, err := w.getWatched(addr)
+	if err == ErrAddressNotWatched {
+		// unwatched while getting the balance
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	mctx, _ := tag.New(context.Background(), tag.Insert(keyAddress, addr))
+	fbalance, _ := new(big.Float).SetInt(balance.Int).Float64()
+	stats.Record(mctx, mBalance.M(fbalance))
+
+	now := time.Now()
+	changed := wa.Balance.Nil() || !wa.Balance.Equals(balance)
+	if changed {
+		if err := w.putSample(wa.Addr, BalanceSample{Height: height, Balance: balance, Time: now}); err != nil {
+			return err
+		}
+	}
+	low := balance.LessThan(wa.Threshold)
+	alert := low && !wa.Low
+	if !changed && low == wa.Low {
+		return nil
+	}
+	if !low && wa.Low {
+		log.Infof("balance of %s recovered to %s", wa.Addr, balance)
+	}
+	wa.Balance = balance
+	wa.Low = low
+	if err := w.putWatched(wa); err != nil {
+		return err
+	}
+	if !alert {
+		return nil
+	}
+	log.Warnf("balance of %s fell to %s, under threshold %s", wa.Addr, balance, wa.Threshold)
+	stats.Record(mctx, mLowBalanceAlerts.M(1))
+	w.publish(LowBalanceAlert{
+		Addr:      wa.Addr,
+		Balance:   balance,
+		Threshold: wa.Threshold,
+		Height:    height,
+		Time:      now,
+	})
+	return nil
+}
+
+// publish sends alert to all listeners, skipping the ones that are full
+func (w *Watcher) publish(alert LowBalanceAlert) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for ch := range w.listeners {
+		select {
+		case ch <- alert:
+		default:
+			log.Warnf("dropping low balance alert of %s for slow listener", alert.Addr)
+		}
+	}
+}
+
+func (w *Watcher) getWatched(addr string) (WatchedAddress, error) {
+	b, err := w.ds.Get(dsBaseWatched.ChildString(addr))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return WatchedAddress{}, ErrAddressNotWatched
+		}
+		return WatchedAddress{}, err
+	}
+	var wa WatchedAddress
+	if err := json.Unmarshal(b, &wa); err != nil {
+		return WatchedAddress{}, err
+	}
+	return wa, nil
+}
+
+func (w *Watcher) putWatched(wa WatchedAddress) error {
+	b, err := json.Marshal(&wa)
+	if err != nil {
+		return err
+	}
+	return w.ds.Put(dsBaseWatched.ChildString(wa.Addr), b)
+}
+
+func (w *Watcher) putSample(addr string, bs BalanceSample) error {
+	b, err := json.Marshal(&bs)
+	if err != nil {
+		return err
+	}
+	key := dsBaseBalances.ChildString(addr).ChildString(fmt.Sprintf("%020d", bs.Time.UnixNano()))
+	return w.ds.Put(key, b)
+}
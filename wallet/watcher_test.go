@@ -0,0 +1,193 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/textileio/filecoin/lotus/types"
+	"github.com/textileio/filecoin/tests"
+	pb "github.com/textileio/filecoin/wallet/pb"
+)
+
+type mockWatcherAPI struct {
+	heads chan []*types.HeadChange
+
+	lock       sync.Mutex
+	balances   map[string]types.BigInt
+	notifyErrs int
+	notified   chan chan []*types.HeadChange
+}
+
+func (a *mockWatcherAPI) ChainNotify(ctx context.Context) (<-chan []*types.HeadChange, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.notifyErrs > 0 {
+		a.notifyErrs--
+		return nil, fmt.Errorf("lotus is unreachable")
+	}
+	if a.notified != nil {
+		a.notified <- a.heads
+	}
+	return a.heads, nil
+}
+
+func (a *mockWatcherAPI) WalletBalance(ctx context.Context, addr string) (types.BigInt, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.balances[addr], nil
+}
+
+func (a *mockWatcherAPI) setBalance(addr string, balance uint64) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.balances[addr] = types.NewInt(balance)
+}
+
+func TestWatcher(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	api := &mockWatcherAPI{heads: make(chan []*types.HeadChange), balances: make(map[string]types.BigInt)}
+	w := NewWatcher(tests.NewTxMapDatastore(), api)
+	defer w.Close()
+	alerts := w.Alerts(ctx)
+
+	checkErr(t, w.Watch("t3addr", types.NewInt(100)))
+	expectAlert := func(expected bool) {
+		t.Helper()
+		wait := time.Millisecond * 100
+		if expected {
+			wait = time.Second * 5
+		}
+		select {
+		case alert := <-alerts:
+			if !expected {
+				t.Fatalf("unexpected alert %v", alert)
+			}
+		case <-time.After(wait):
+			if expected {
+				t.Fatal("expected a low balance alert")
+			}
+		}
+	}
+	head := func(height uint64) {
+		api.heads <- []*types.HeadChange{{Type: types.HCApply, Val: &types.TipSet{Height: height}}}
+		// an empty change is received once the head is processed
+		api.heads <- nil
+	}
+
+	api.setBalance("t3addr", 150)
+	head(1)
+	head(2)
+	expectAlert(false)
+	api.setBalance("t3addr", 50)
+	head(3)
+	expectAlert(true)
+	// still under the threshold
+	api.setBalance("t3addr", 40)
+	head(4)
+	expectAlert(false)
+	api.setBalance("t3addr", 200)
+	head(5)
+	api.setBalance("t3addr", 10)
+	head(6)
+	expectAlert(true)
+
+	history, err := w.History("t3addr", time.Time{}, time.Time{})
+	checkErr(t, err)
+	expected := []uint64{1, 3, 4, 5, 6}
+	if len(history) != len(expected) {
+		t.Fatalf("expected %d balance changes, got %v", len(expected), history)
+	}
+	for i, h := range expected {
+		if history[i].Height != h {
+			t.Fatalf("expected change at height %d, got %d", h, history[i].Height)
+		}
+	}
+
+	watched, err := w.Watched()
+	checkErr(t, err)
+	if len(watched) != 1 || !watched[0].Low || !watched[0].Balance.Equals(types.NewInt(10)) {
+		t.Fatalf("unexpected watched addresses %v", watched)
+	}
+	checkErr(t, w.Unwatch("t3addr"))
+	if err := w.Unwatch("t3addr"); err != ErrAddressNotWatched {
+		t.Fatalf("expected ErrAddressNotWatched, got %v", err)
+	}
+}
+
+func TestWatcherResubscribe(t *testing.T) {
+	t.Parallel()
+	api := &mockWatcherAPI{
+		balances:   make(map[string]types.BigInt),
+		notifyErrs: 1,
+		notified:   make(chan chan []*types.HeadChange, 1),
+	}
+	api.heads = make(chan []*types.HeadChange)
+	w := NewWatcher(tests.NewTxMapDatastore(), api)
+	defer w.Close()
+	checkErr(t, w.Watch("t3addr", types.NewInt(0)))
+
+	subscribed := func() chan []*types.HeadChange {
+		t.Helper()
+		select {
+		case heads := <-api.notified:
+			return heads
+		case <-time.After(time.Second * 5):
+			t.Fatal("watcher didn't subscribe again")
+			return nil
+		}
+	}
+	head := func(heads chan []*types.HeadChange, height uint64) {
+		heads <- []*types.HeadChange{{Type: types.HCApply, Val: &types.TipSet{Height: height}}}
+		heads <- nil
+	}
+
+	// the first subscription fails
+	heads := subscribed()
+	api.setBalance("t3addr", 10)
+	head(heads, 1)
+
+	// the notify channel gets closed
+	api.lock.Lock()
+	api.heads = make(chan []*types.HeadChange)
+	api.lock.Unlock()
+	close(heads)
+	heads = subscribed()
+	api.setBalance("t3addr", 20)
+	head(heads, 2)
+
+	history, err := w.History("t3addr", time.Time{}, time.Time{})
+	checkErr(t, err)
+	if len(history) != 2 || history[1].Height != 2 {
+		t.Fatalf("expected balance changes after subscribing again, got %v", history)
+	}
+}
+
+func TestServiceBalanceHistory(t *testing.T) {
+	t.Parallel()
+	api := &mockWatcherAPI{heads: make(chan []*types.HeadChange), balances: make(map[string]types.BigInt)}
+	w := NewWatcher(tests.NewTxMapDatastore(), api)
+	defer w.Close()
+	s := NewService(nil, w)
+	checkErr(t, w.Watch("t3addr", types.NewInt(0)))
+	checkErr(t, w.updateBalance("t3addr", types.NewInt(10), 1))
+	history, err := w.History("t3addr", time.Time{}, time.Time{})
+	checkErr(t, err)
+	sampled := history[0].Time
+
+	ctx := context.Background()
+	reply, err := s.BalanceHistory(ctx, &pb.BalanceHistoryRequest{Address: "t3addr", From: sampled.UnixNano()})
+	checkErr(t, err)
+	if len(reply.GetSamples()) != 1 || reply.GetSamples()[0].GetTime() != sampled.UnixNano() {
+		t.Fatalf("expected the sample at %d, got %v", sampled.UnixNano(), reply.GetSamples())
+	}
+	reply, err = s.BalanceHistory(ctx, &pb.BalanceHistoryRequest{Address: "t3addr", From: sampled.UnixNano() + 1})
+	checkErr(t, err)
+	if len(reply.GetSamples()) != 0 {
+		t.Fatalf("expected no samples after %d, got %v", sampled.UnixNano(), reply.GetSamples())
+	}
+}